@@ -0,0 +1,69 @@
+package kumaclient
+
+import (
+	"context"
+	"errors"
+)
+
+// Sessions expire: the REST bridge hands out short-lived access tokens and
+// Uptime Kuma drops idle Socket.IO sessions. Every public Client method runs
+// through withAuth, which logs in again and retries the call once when the
+// backend reports that the client is no longer authenticated.
+//
+// Re-authentication is serialized and tracked by a generation counter, so
+// when many resources hit an expired session at once only the first of them
+// logs in and the rest simply retry with the fresh session.
+
+// generation returns the current login generation.
+func (c *Client) generation() uint64 {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	return c.authGen
+}
+
+// reauthenticate logs in again unless another caller already did so since
+// generation gen was observed.
+func (c *Client) reauthenticate(ctx context.Context, gen uint64) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if c.authGen != gen {
+		return nil
+	}
+
+	if err := c.backend.login(ctx); err != nil {
+		return err
+	}
+	c.authGen++
+
+	return nil
+}
+
+// withAuth runs fn, logging in again and retrying once if it fails with
+// ErrUnauthorized.
+func withAuth[T any](ctx context.Context, c *Client, fn func() (T, error)) (T, error) {
+	var zero T
+
+	gen := c.generation()
+
+	v, err := fn()
+	if !errors.Is(err, ErrUnauthorized) {
+		return v, err
+	}
+
+	if loginErr := c.reauthenticate(ctx, gen); loginErr != nil {
+		return zero, loginErr
+	}
+
+	return fn()
+}
+
+// doWithAuth is withAuth for calls that only return an error.
+func doWithAuth(ctx context.Context, c *Client, fn func() error) error {
+	_, err := withAuth(ctx, c, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+
+	return err
+}
@@ -0,0 +1,136 @@
+package kumaclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+// expiringBridge is a REST bridge stand-in whose access tokens expire on
+// demand. Each login hands out a new token and only the latest is accepted.
+type expiringBridge struct {
+	mu     sync.Mutex
+	gen    int // bumped by every login and expiry
+	logins int
+
+	// stale holds requests made with an expired token until that many have
+	// arrived, so that they all fail at the same time.
+	stale    int
+	arrived  int
+	released chan struct{}
+}
+
+func (b *expiringBridge) token() string {
+	return fmt.Sprintf("token-%d", b.gen)
+}
+
+// expire invalidates the current token; the next stale requests are held
+// until all of them have arrived.
+func (b *expiringBridge) expire(stale int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.gen++
+	b.stale = stale
+	b.arrived = 0
+	b.released = make(chan struct{})
+}
+
+func (b *expiringBridge) loginCount() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.logins
+}
+
+func (b *expiringBridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	b.mu.Lock()
+	if r.URL.Path == "/login/access-token" {
+		b.gen++
+		b.logins++
+		fmt.Fprintf(w, `{"access_token":%q,"token_type":"bearer"}`, b.token())
+		b.mu.Unlock()
+		return
+	}
+
+	if r.Header.Get("Authorization") != "Bearer "+b.token() {
+		b.arrived++
+		released := b.released
+		if b.arrived == b.stale {
+			close(released)
+		}
+		b.mu.Unlock()
+
+		if released != nil {
+			<-released
+		}
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, `{"detail":"Could not validate credentials"}`)
+		return
+	}
+	b.mu.Unlock()
+
+	_, _ = io.WriteString(w, `{"monitor":{"id":1,"name":"api","type":"http"}}`)
+}
+
+func newExpiringClient(t *testing.T, b *expiringBridge) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(b)
+	t.Cleanup(srv.Close)
+
+	c, err := newTestRESTClient(srv.URL)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	return c
+}
+
+func TestWithAuthLogsInAgainOn401(t *testing.T) {
+	b := &expiringBridge{}
+	c := newExpiringClient(t, b)
+
+	b.expire(1)
+	m, err := c.GetMonitor(context.Background(), 1)
+	if err != nil {
+		t.Fatalf("GetMonitor with an expired token: %v", err)
+	}
+	if m.Name != "api" {
+		t.Errorf("GetMonitor = %+v", m)
+	}
+
+	if got := b.loginCount(); got != 2 {
+		t.Errorf("logins = %d, want the initial one and one more", got)
+	}
+}
+
+func TestWithAuthConcurrent401sLogInOnce(t *testing.T) {
+	b := &expiringBridge{}
+	c := newExpiringClient(t, b)
+
+	const calls = 8
+	b.expire(calls)
+
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetMonitor(context.Background(), 1); err != nil {
+				t.Errorf("GetMonitor: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := b.loginCount() - 1; got != 1 {
+		t.Errorf("%d concurrent 401s caused %d logins, want 1", calls, got)
+	}
+}
@@ -0,0 +1,205 @@
+// Package kumaclient is a typed client for the Uptime Kuma API. The provider
+// builds a single Client in Configure and hands it to every resource and data
+// source as ProviderData.
+//
+// Two backends are supported: the third-party REST bridge
+// (medaziz11/uptimekuma_restapi) and Uptime Kuma's own Socket.IO API.
+package kumaclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend names accepted in Config.Backend.
+const (
+	BackendRESTBridge = "rest_bridge"
+	BackendSocketIO   = "socketio"
+)
+
+// Config holds the settings used to build a Client.
+type Config struct {
+	// Host is the base URL of the API: the REST bridge (e.g.
+	// http://localhost:8000) or the Uptime Kuma instance itself when using
+	// the Socket.IO backend (e.g. http://localhost:3001).
+	Host     string
+	Username string
+	Password string
+
+	// Token is a session token from an earlier login (the REST bridge's
+	// access token, or the JWT Uptime Kuma hands out on login). When set,
+	// the client uses it instead of logging in, and only falls back to
+	// Username and Password once it is rejected.
+	Token string
+
+	// APIKey is an Uptime Kuma API key, sent as the password of HTTP basic
+	// auth on every request. Only the REST bridge backend accepts it; there
+	// is no session to renew, so it cannot be combined with Token.
+	APIKey string
+
+	// TOTPSecret is the base32 secret of the account's two-factor
+	// authentication. When set, the current code is sent with every login.
+	TOTPSecret string
+
+	// Backend selects how the client talks to Uptime Kuma. Defaults to
+	// BackendRESTBridge.
+	Backend string
+
+	// TLS holds the certificate and proxy settings. They are ignored when
+	// HTTPClient is set.
+	TLS TLSConfig
+
+	// RequestTimeout bounds each individual request, including login. Zero
+	// means no timeout.
+	RequestTimeout time.Duration
+
+	// HTTPClient overrides the HTTP client built from TLS and
+	// RequestTimeout; mostly useful in tests.
+	HTTPClient *http.Client
+
+	// MaxRetries is how many times a failed request is retried; zero
+	// disables retries. See retryTransport for which failures are retried.
+	MaxRetries int
+	// RetryWaitMin and RetryWaitMax bound the exponential backoff between
+	// retries.
+	RetryWaitMin time.Duration
+	RetryWaitMax time.Duration
+
+	// RequestsPerSecond caps the rate of HTTP requests made through the
+	// client, retries and logins included. Zero means unlimited.
+	RequestsPerSecond float64
+}
+
+// backend is implemented by each way of talking to Uptime Kuma. Methods
+// return *Error on failure.
+type backend interface {
+	// connect prepares the backend for the first call, logging in unless a
+	// configured token or API key makes that unnecessary.
+	connect(ctx context.Context) error
+	// login authenticates again after the session was rejected.
+	login(ctx context.Context) error
+	close() error
+
+	listMonitors(ctx context.Context) ([]Monitor, error)
+	getMonitor(ctx context.Context, id int64) (*Monitor, error)
+	createMonitor(ctx context.Context, m *Monitor) (int64, error)
+	updateMonitor(ctx context.Context, id int64, m *Monitor) error
+	deleteMonitor(ctx context.Context, id int64) error
+
+	listTags(ctx context.Context) ([]Tag, error)
+	getTag(ctx context.Context, id int64) (*Tag, error)
+	createTag(ctx context.Context, t *Tag) (*Tag, error)
+	updateTag(ctx context.Context, id int64, t *Tag) error
+	deleteTag(ctx context.Context, id int64) error
+	addMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error
+	deleteMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error
+
+	listUsers(ctx context.Context) ([]User, error)
+	getUser(ctx context.Context, username string) (*User, error)
+
+	listNotifications(ctx context.Context) ([]Notification, error)
+	getNotification(ctx context.Context, id int64) (*Notification, error)
+	createNotification(ctx context.Context, n *Notification) (int64, error)
+	updateNotification(ctx context.Context, id int64, n *Notification) error
+	deleteNotification(ctx context.Context, id int64) error
+
+	serverInfo(ctx context.Context) (*ServerInfo, error)
+}
+
+// Client talks to Uptime Kuma on behalf of the provider. It is safe for
+// concurrent use once New has returned.
+type Client struct {
+	host    string
+	backend backend
+
+	authMu  sync.Mutex
+	authGen uint64
+}
+
+// New builds a Client from cfg and logs in with the configured credentials,
+// unless a token or API key lets it skip the login round-trip.
+func New(ctx context.Context, cfg Config) (*Client, error) {
+	if cfg.Host == "" {
+		return nil, configError(errors.New("host is required"))
+	}
+	if cfg.APIKey != "" && cfg.Token != "" {
+		return nil, configError(errors.New("api key and token are mutually exclusive"))
+	}
+	if cfg.APIKey == "" && cfg.Token == "" && (cfg.Username == "" || cfg.Password == "") {
+		return nil, configError(errors.New("either username and password, a token or an api key is required"))
+	}
+	if cfg.TOTPSecret != "" {
+		if _, err := decodeTOTPSecret(cfg.TOTPSecret); err != nil {
+			return nil, configError(err)
+		}
+	}
+
+	cfg.Host = strings.TrimRight(cfg.Host, "/")
+
+	httpClient := http.Client{Timeout: cfg.RequestTimeout}
+	if cfg.HTTPClient != nil {
+		httpClient = *cfg.HTTPClient
+	} else {
+		transport, err := newHTTPTransport(cfg.TLS)
+		if err != nil {
+			return nil, configError(err)
+		}
+		httpClient.Transport = transport
+	}
+	limiter := newRateLimiter(cfg.RequestsPerSecond)
+	httpClient.Transport = newRetryTransport(newRateLimitTransport(newLoggingTransport(httpClient.Transport, cfg), limiter), cfg)
+	cfg.HTTPClient = &httpClient
+
+	c := &Client{host: cfg.Host}
+
+	switch cfg.Backend {
+	case "", BackendRESTBridge:
+		c.backend = newRESTBackend(cfg)
+	case BackendSocketIO:
+		if cfg.APIKey != "" {
+			return nil, configError(errors.New("api keys are not supported by the socketio backend, use a token instead"))
+		}
+		c.backend = newSocketBackend(cfg)
+	default:
+		return nil, configError(fmt.Errorf("unknown backend %q", cfg.Backend))
+	}
+
+	if err := c.backend.connect(ctx); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// configError marks err as a problem with the Config passed to New.
+func configError(err error) error {
+	return fmt.Errorf("kumaclient: %w: %w", ErrInvalidConfig, err)
+}
+
+// Host returns the base URL the client talks to.
+func (c *Client) Host() string {
+	return c.host
+}
+
+// Login authenticates again with the configured credentials.
+func (c *Client) Login(ctx context.Context) error {
+	c.authMu.Lock()
+	defer c.authMu.Unlock()
+
+	if err := c.backend.login(ctx); err != nil {
+		return err
+	}
+	c.authGen++
+
+	return nil
+}
+
+// Close releases any connection held by the client.
+func (c *Client) Close() error {
+	return c.backend.close()
+}
@@ -0,0 +1,164 @@
+package kumaclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+)
+
+var (
+	// ErrNotFound matches any error caused by the API reporting that the
+	// requested object does not exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrUnauthorized matches any error caused by the API rejecting the
+	// client's credentials.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrTOTPRequired is returned by login when the account has two-factor
+	// authentication enabled and no TOTP secret is configured.
+	ErrTOTPRequired = errors.New("two-factor authentication code required")
+
+	// ErrInvalidConfig matches errors caused by a Config that cannot work,
+	// such as a malformed CA bundle or conflicting credentials.
+	ErrInvalidConfig = errors.New("invalid configuration")
+
+	// ErrNotSupported is returned for calls the selected backend cannot serve.
+	ErrNotSupported = errors.New("not supported by this backend")
+)
+
+// Error describes a failed call to the Uptime Kuma API.
+type Error struct {
+	// Op is a short description of the call, e.g. "get monitor 12".
+	Op string
+	// StatusCode is the HTTP status of the response, or 0 if none was received.
+	StatusCode int
+	// Message is the error message returned by the server, if any.
+	Message string
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode == 0 {
+		return fmt.Sprintf("%s: %v", e.Op, e.Err)
+	}
+
+	msg := fmt.Sprintf("%s: unexpected status %d %s", e.Op, e.StatusCode, http.StatusText(e.StatusCode))
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether e matches one of the package's sentinel errors.
+func (e *Error) Is(target error) bool {
+	switch target {
+	case ErrNotFound:
+		return e.StatusCode == http.StatusNotFound || isNotFoundMessage(e.Message)
+	case ErrUnauthorized:
+		return e.StatusCode == http.StatusUnauthorized
+	}
+
+	return false
+}
+
+// IsNotFound reports whether err was caused by a missing object.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// isNotFoundMessage reports whether an error message from either backend says
+// the object does not exist. The REST bridge passes through the messages of
+// the Python client ("Monitor does not exist", "Monitor not found !"), and
+// Uptime Kuma's getMonitor fails on a null row rather than saying so.
+func isNotFoundMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+
+	return strings.Contains(msg, "not found") ||
+		strings.Contains(msg, "does not exist") ||
+		strings.Contains(msg, "reading 'tojson'")
+}
+
+// IsTLSError reports whether err was caused by the TLS handshake, typically
+// a server certificate that is not trusted or does not match the host.
+func IsTLSError(err error) bool {
+	var (
+		unknownAuthority x509.UnknownAuthorityError
+		invalidCert      x509.CertificateInvalidError
+		hostname         x509.HostnameError
+		verification     *tls.CertificateVerificationError
+		recordHeader     tls.RecordHeaderError
+	)
+
+	return errors.As(err, &unknownAuthority) ||
+		errors.As(err, &invalidCert) ||
+		errors.As(err, &hostname) ||
+		errors.As(err, &verification) ||
+		errors.As(err, &recordHeader)
+}
+
+// IsConnectionError reports whether err means the server could not be
+// reached at all: DNS failures, refused connections and timeouts.
+func IsConnectionError(err error) bool {
+	if isConnectError(err) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// errorMessage pulls a human readable message out of an error response body.
+// The REST bridge answers with FastAPI's {"detail": ...} shape, Uptime Kuma
+// itself with {"msg": ...}; anything else is returned as-is.
+func errorMessage(body string) string {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return ""
+	}
+
+	var parsed struct {
+		Detail  json.RawMessage `json:"detail"`
+		Message string          `json:"message"`
+		Msg     string          `json:"msg"`
+	}
+	if err := json.Unmarshal([]byte(body), &parsed); err != nil {
+		return body
+	}
+
+	if len(parsed.Detail) > 0 {
+		var detail string
+		if json.Unmarshal(parsed.Detail, &detail) == nil {
+			return detail
+		}
+
+		var nested struct {
+			Message string `json:"message"`
+		}
+		if json.Unmarshal(parsed.Detail, &nested) == nil && nested.Message != "" {
+			return nested.Message
+		}
+
+		return string(parsed.Detail)
+	}
+
+	if parsed.Message != "" {
+		return parsed.Message
+	}
+	if parsed.Msg != "" {
+		return parsed.Msg
+	}
+
+	return body
+}
@@ -0,0 +1,21 @@
+package kumaclient
+
+import (
+	"context"
+)
+
+// ServerInfo describes the Uptime Kuma server.
+type ServerInfo struct {
+	Version              string `json:"version"`
+	LatestVersion        string `json:"latestVersion"`
+	PrimaryBaseUrl       string `json:"primaryBaseUrl"`
+	ServerTimezone       string `json:"serverTimezone"`
+	ServerTimezoneOffset string `json:"serverTimezoneOffset"`
+}
+
+// GetServerInfo returns information about the server.
+func (c *Client) GetServerInfo(ctx context.Context) (*ServerInfo, error) {
+	return withAuth(ctx, c, func() (*ServerInfo, error) {
+		return c.backend.serverInfo(ctx)
+	})
+}
@@ -0,0 +1,123 @@
+package kumaclient
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// maxLoggedBody caps how much of a request or response body is logged.
+const maxLoggedBody = 16 << 10
+
+// loggingTransport logs every HTTP exchange through tflog: the request line
+// and status at DEBUG, headers and bodies at TRACE. Secrets never reach the
+// log: auth headers and sensitive body fields are redacted, and the
+// configured credentials are masked wherever they appear.
+type loggingTransport struct {
+	base    http.RoundTripper
+	secrets []string
+}
+
+func newLoggingTransport(base http.RoundTripper, cfg Config) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	t := &loggingTransport{base: base}
+	for _, s := range []string{cfg.Password, cfg.Token, cfg.APIKey, cfg.TOTPSecret, cfg.TLS.ClientKeyPEM} {
+		if s != "" {
+			t.secrets = append(t.secrets, s)
+		}
+	}
+
+	return t
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	if len(t.secrets) > 0 {
+		ctx = tflog.MaskAllFieldValuesStrings(ctx, t.secrets...)
+		ctx = tflog.MaskMessageStrings(ctx, t.secrets...)
+	}
+
+	fields := map[string]any{
+		"method": req.Method,
+		"url":    req.URL.Redacted(),
+	}
+
+	tflog.Trace(ctx, "Uptime Kuma API request", map[string]any{
+		"method":  req.Method,
+		"url":     req.URL.Redacted(),
+		"headers": redactHeaders(req.Header),
+		"body":    requestBody(req),
+	})
+
+	start := time.Now()
+	resp, err := t.base.RoundTrip(req)
+	fields["duration"] = time.Since(start).String()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Uptime Kuma API request failed", fields)
+		return nil, err
+	}
+
+	fields["status"] = resp.StatusCode
+	tflog.Debug(ctx, "Uptime Kuma API request", fields)
+
+	// Buffer the body so it can be logged and still handed to the caller.
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	tflog.Trace(ctx, "Uptime Kuma API response", map[string]any{
+		"status":  resp.StatusCode,
+		"headers": redactHeaders(resp.Header),
+		"body":    loggedBody(body),
+	})
+
+	return resp, nil
+}
+
+// requestBody returns the redacted body of req without consuming it.
+func requestBody(req *http.Request) string {
+	if req.Body == nil || req.GetBody == nil {
+		return ""
+	}
+
+	rc, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer rc.Close()
+
+	body, err := io.ReadAll(io.LimitReader(rc, maxLoggedBody+1))
+	if err != nil {
+		return ""
+	}
+
+	return loggedBody(body)
+}
+
+func loggedBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	truncated := len(body) > maxLoggedBody
+	if truncated {
+		body = body[:maxLoggedBody]
+	}
+
+	out := redactBody(body)
+	if truncated {
+		out += "...(truncated)"
+	}
+
+	return out
+}
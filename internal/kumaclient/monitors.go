@@ -0,0 +1,187 @@
+package kumaclient
+
+import (
+	"context"
+	"encoding/json"
+)
+
+// MonitorTag is a tag attached to a monitor, together with the per-monitor value.
+type MonitorTag struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+	Value string `json:"value"`
+}
+
+// UnmarshalJSON identifies the tag by tag_id when the server sends it.
+// Uptime Kuma's monitor tags carry both the id of the association and the
+// tag_id of the tag, and only the latter means anything to callers.
+func (t *MonitorTag) UnmarshalJSON(data []byte) error {
+	type plain MonitorTag
+	var v struct {
+		plain
+		TagID *int64 `json:"tag_id"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*t = MonitorTag(v.plain)
+	if v.TagID != nil {
+		t.ID = *v.TagID
+	}
+
+	return nil
+}
+
+// Monitor is the API representation of an Uptime Kuma monitor. Optional
+// settings are pointers and, like nil slices, are left out of requests when
+// nil, so Uptime Kuma applies its own defaults to them.
+type Monitor struct {
+	ID                                  int64        `json:"id"`
+	Type                                string       `json:"type"`
+	Name                                string       `json:"name"`
+	Interval                            *int64       `json:"interval"`
+	RetryInterval                       *int64       `json:"retry_interval"`
+	ResendInterval                      *int64       `json:"resend_interval"`
+	MaxRetries                          *int64       `json:"max_retries"`
+	UpsideDown                          *bool        `json:"upside_down"`
+	NotificationIDList                  []int64      `json:"notification_id_list"`
+	URL                                 *string      `json:"url"`
+	ExpiryNotification                  *bool        `json:"expiry_notification"`
+	IgnoreTls                           *bool        `json:"ignore_tls"`
+	MaxRedirects                        *int64       `json:"max_redirects"`
+	AcceptedStatusCodes                 []string     `json:"accepted_statuscodes"`
+	ProxyID                             *int64       `json:"proxy_id"`
+	Method                              *string      `json:"method"`
+	Body                                *string      `json:"body"`
+	Headers                             *string      `json:"headers"`
+	AuthMethod                          *string      `json:"auth_method"`
+	BasicAuthUser                       *string      `json:"basic_auth_user"`
+	BasicAuthPass                       *string      `json:"basic_auth_pass"`
+	AuthDomain                          *string      `json:"auth_domain"`
+	AuthWorkstation                     *string      `json:"auth_workstation"`
+	Keyword                             *string      `json:"keyword"`
+	Hostname                            *string      `json:"hostname"`
+	Port                                *int64       `json:"port"`
+	DNSResolveServer                    *string      `json:"dns_resolve_server"`
+	DNSResolveType                      *string      `json:"dns_resolve_type"`
+	MQTTUsername                        *string      `json:"mqtt_username"`
+	MQTTPassword                        *string      `json:"mqtt_password"`
+	MQTTTopic                           *string      `json:"mqtt_topic"`
+	MQTTSucessMessage                   *string      `json:"mqtt_success_message"`
+	DatabaseConnectionString            *string      `json:"database_connection_string"`
+	DatabaseQuery                       *string      `json:"database_query"`
+	DockerContainer                     *string      `json:"docker_container"`
+	DockerHost                          *int64       `json:"docker_host"`
+	RadiusUsername                      *string      `json:"radius_username"`
+	RadiusPassword                      *string      `json:"radius_password"`
+	RadiusSecret                        *string      `json:"radius_secret"`
+	RadiusCalledStationId               *string      `json:"radius_called_station_id"`
+	RadiusCallingStationId              *string      `json:"radius_calling_station_id"`
+	Active                              *bool        `json:"active"`
+	ForceInactive                       *bool        `json:"force_inactive"`
+	Game                                *string      `json:"game"`
+	GamedigGivenPortOnly                *bool        `json:"gamedig_given_port_only"`
+	GrpcBody                            *string      `json:"grpc_body"`
+	GrpcEnableTls                       *bool        `json:"grpc_enable_tls"`
+	GrpcMetadata                        *string      `json:"grpc_metadata"`
+	GrpcMethod                          *string      `json:"grpc_method"`
+	GrpcProtobuf                        *string      `json:"grpc_protobuf"`
+	GrpcServiceName                     *string      `json:"grpc_service_name"`
+	GrpcUrl                             *string      `json:"grpc_url"`
+	HttpBodyEncoding                    *string      `json:"http_body_encoding"`
+	IncludeSensitiveData                *bool        `json:"include_sensitive_data"`
+	InvertKeyword                       *bool        `json:"invert_keyword"`
+	JsonPath                            *string      `json:"json_path"`
+	KafkaProducerAllowAutoTopicCreation *bool        `json:"kafka_producer_allow_auto_topic_creation"`
+	KafkaProducerBrokers                []string     `json:"kafka_producer_brokers"`
+	KafkaProducerMessage                *string      `json:"kafka_producer_message"`
+	KafkaProducerSaslOptions            *string      `json:"kafka_producer_sasl_options"`
+	KafkaProducerSsl                    *bool        `json:"kafka_producer_ssl"`
+	KafkaProducerTopic                  *string      `json:"kafka_producer_topic"`
+	Maintenance                         *bool        `json:"maintenance"`
+	OAuthAuthMethod                     *string      `json:"oauth_auth_method"`
+	OAuthClientID                       *string      `json:"oauth_client_id"`
+	OAuthClientSecret                   *string      `json:"oauth_client_secret"`
+	OAuthScopes                         []string     `json:"oauth_scopes"`
+	OAuthTokenURL                       *string      `json:"oauth_token_url"`
+	PacketSize                          *int64       `json:"packet_size"`
+	Parent                              *string      `json:"parent"`
+	PathName                            *string      `json:"path_name"`
+	PushToken                           *string      `json:"push_token"`
+	Screenshot                          *string      `json:"screenshot"`
+	Tags                                []MonitorTag `json:"tags"`
+	Timeout                             *int64       `json:"timeout"`
+	TlsCa                               *string      `json:"tls_ca"`
+	TlsCert                             *string      `json:"tls_cert"`
+	TlsKey                              *string      `json:"tls_key"`
+	Weight                              *int64       `json:"weight"`
+}
+
+// MarshalJSON leaves out the fields that are nil.
+func (m Monitor) MarshalJSON() ([]byte, error) {
+	type plain Monitor
+	data, err := json.Marshal(plain(m))
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range fields {
+		if string(v) == "null" {
+			delete(fields, k)
+		}
+	}
+
+	return json.Marshal(fields)
+}
+
+// ListMonitors returns every monitor visible to the client.
+func (c *Client) ListMonitors(ctx context.Context) ([]Monitor, error) {
+	return withAuth(ctx, c, func() ([]Monitor, error) {
+		return c.backend.listMonitors(ctx)
+	})
+}
+
+// GetMonitor returns the monitor with the given id.
+func (c *Client) GetMonitor(ctx context.Context, id int64) (*Monitor, error) {
+	return withAuth(ctx, c, func() (*Monitor, error) {
+		return c.backend.getMonitor(ctx, id)
+	})
+}
+
+// CreateMonitor creates m and returns the monitor as stored by the server.
+func (c *Client) CreateMonitor(ctx context.Context, m *Monitor) (*Monitor, error) {
+	id, err := withAuth(ctx, c, func() (int64, error) {
+		return c.backend.createMonitor(ctx, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetMonitor(ctx, id)
+}
+
+// UpdateMonitor replaces the monitor with the given id by m and returns the
+// monitor as stored by the server.
+func (c *Client) UpdateMonitor(ctx context.Context, id int64, m *Monitor) (*Monitor, error) {
+	err := doWithAuth(ctx, c, func() error {
+		return c.backend.updateMonitor(ctx, id, m)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetMonitor(ctx, id)
+}
+
+// DeleteMonitor deletes the monitor with the given id.
+func (c *Client) DeleteMonitor(ctx context.Context, id int64) error {
+	return doWithAuth(ctx, c, func() error {
+		return c.backend.deleteMonitor(ctx, id)
+	})
+}
@@ -0,0 +1,60 @@
+package kumaclient
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMonitorMarshalLeavesOutNil(t *testing.T) {
+	retries, url := int64(0), "https://example.com"
+	m := Monitor{
+		Type:                "http",
+		Name:                "api",
+		MaxRetries:          &retries,
+		URL:                 &url,
+		AcceptedStatusCodes: []string{},
+	}
+
+	data, err := json.Marshal(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]any{
+		"id":                   float64(0),
+		"type":                 "http",
+		"name":                 "api",
+		"max_retries":          float64(0),
+		"url":                  "https://example.com",
+		"accepted_statuscodes": []any{},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Marshal = %s, want %v", data, want)
+	}
+
+	var back Monitor
+	if err := json.Unmarshal(data, &back); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(back, m) {
+		t.Errorf("round trip = %+v, want %+v", back, m)
+	}
+}
+
+func TestToKumaMonitorLeavesOutNil(t *testing.T) {
+	km, err := toKumaMonitor(&Monitor{Type: "ping", Name: "gateway"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, key := range []string{"interval", "maxretries", "upsideDown", "active", "timeout", "oauth_scopes"} {
+		if v, ok := km[key]; ok {
+			t.Errorf("toKumaMonitor sent %s = %v for an unset field", key, v)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package kumaclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// Notification is a configured notification provider. Uptime Kuma stores the
+// provider-specific settings (webhook URLs, SMTP hosts, ...) as flat keys next
+// to the common ones; those end up in Config.
+type Notification struct {
+	ID            int64
+	Name          string
+	Type          string
+	Active        bool
+	IsDefault     bool
+	ApplyExisting bool
+	Config        map[string]any
+}
+
+// notificationKeys are the keys that map onto Notification's named fields
+// rather than Config.
+var notificationKeys = map[string]bool{
+	"id":            true,
+	"name":          true,
+	"type":          true,
+	"active":        true,
+	"isDefault":     true,
+	"applyExisting": true,
+	"userId":        true,
+	"user_id":       true,
+	"is_default":    true,
+	"config":        true,
+}
+
+func (n Notification) MarshalJSON() ([]byte, error) {
+	out := make(map[string]any, len(n.Config)+6)
+	for k, v := range n.Config {
+		out[k] = v
+	}
+	if n.ID != 0 {
+		out["id"] = n.ID
+	}
+	out["name"] = n.Name
+	out["type"] = n.Type
+	out["active"] = n.Active
+	out["isDefault"] = n.IsDefault
+	out["applyExisting"] = n.ApplyExisting
+
+	return json.Marshal(out)
+}
+
+func (n *Notification) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var common struct {
+		ID            int64  `json:"id"`
+		Name          string `json:"name"`
+		Type          string `json:"type"`
+		Active        any    `json:"active"`
+		IsDefault     any    `json:"isDefault"`
+		ApplyExisting bool   `json:"applyExisting"`
+		Config        string `json:"config"`
+	}
+	if err := json.Unmarshal(data, &common); err != nil {
+		return err
+	}
+
+	*n = Notification{
+		ID:            common.ID,
+		Name:          common.Name,
+		Type:          common.Type,
+		Active:        truthy(common.Active),
+		IsDefault:     truthy(common.IsDefault),
+		ApplyExisting: common.ApplyExisting,
+		Config:        map[string]any{},
+	}
+
+	// The raw database row keeps the provider settings JSON-encoded in
+	// "config"; unpack it so both shapes end up the same.
+	if common.Config != "" {
+		var cfg map[string]any
+		if err := json.Unmarshal([]byte(common.Config), &cfg); err != nil {
+			return fmt.Errorf("decoding notification config: %w", err)
+		}
+		for k, v := range cfg {
+			if !notificationKeys[k] {
+				n.Config[k] = v
+			}
+		}
+		if n.Type == "" {
+			n.Type, _ = cfg["type"].(string)
+		}
+		// applyExisting has no column of its own and only survives here.
+		if !n.ApplyExisting {
+			n.ApplyExisting = truthy(cfg["applyExisting"])
+		}
+	}
+
+	for k, v := range raw {
+		if notificationKeys[k] {
+			continue
+		}
+		var val any
+		if err := json.Unmarshal(v, &val); err != nil {
+			return err
+		}
+		n.Config[k] = val
+	}
+
+	return nil
+}
+
+// truthy interprets the booleans Uptime Kuma returns, which come back as
+// either JSON booleans or SQLite 0/1 integers depending on the endpoint.
+func truthy(v any) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case float64:
+		return b != 0
+	}
+
+	return false
+}
+
+// ListNotifications returns every notification provider.
+func (c *Client) ListNotifications(ctx context.Context) ([]Notification, error) {
+	return withAuth(ctx, c, func() ([]Notification, error) {
+		return c.backend.listNotifications(ctx)
+	})
+}
+
+// GetNotification returns the notification provider with the given id.
+func (c *Client) GetNotification(ctx context.Context, id int64) (*Notification, error) {
+	return withAuth(ctx, c, func() (*Notification, error) {
+		return c.backend.getNotification(ctx, id)
+	})
+}
+
+// CreateNotification creates n and returns the notification as stored by the
+// server.
+func (c *Client) CreateNotification(ctx context.Context, n *Notification) (*Notification, error) {
+	id, err := withAuth(ctx, c, func() (int64, error) {
+		return c.backend.createNotification(ctx, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetNotification(ctx, id)
+}
+
+// UpdateNotification replaces the notification with the given id by n and
+// returns the notification as stored by the server.
+func (c *Client) UpdateNotification(ctx context.Context, id int64, n *Notification) (*Notification, error) {
+	err := doWithAuth(ctx, c, func() error {
+		return c.backend.updateNotification(ctx, id, n)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetNotification(ctx, id)
+}
+
+// DeleteNotification deletes the notification with the given id, detaching
+// it from every monitor.
+func (c *Client) DeleteNotification(ctx context.Context, id int64) error {
+	return doWithAuth(ctx, c, func() error {
+		return c.backend.deleteNotification(ctx, id)
+	})
+}
@@ -0,0 +1,100 @@
+package kumaclient
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// rateLimiter spaces requests evenly so that no more than a fixed number
+// start per second. It is shared by everything using the same Client, which
+// is what keeps `terraform apply -parallelism=N` from flooding the server.
+type rateLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// newRateLimiter returns a limiter allowing perSecond calls per second, or
+// nil if perSecond is not positive.
+func newRateLimiter(perSecond float64) *rateLimiter {
+	if perSecond <= 0 {
+		return nil
+	}
+
+	return &rateLimiter{interval: time.Duration(float64(time.Second) / perSecond)}
+}
+
+// wait blocks until the caller may start its call. A nil limiter never
+// blocks.
+func (l *rateLimiter) wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(l.interval)
+	l.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitTransport makes every request wait its turn on the limiter. It sits
+// below retryTransport, so each retry and each login waits as well.
+type rateLimitTransport struct {
+	base    http.RoundTripper
+	limiter *rateLimiter
+}
+
+func newRateLimitTransport(base http.RoundTripper, l *rateLimiter) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if l == nil {
+		return base
+	}
+
+	return &rateLimitTransport{base: base, limiter: l}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Context().Value(unlimitedKey{}) == nil {
+		if err := t.limiter.wait(req.Context()); err != nil {
+			if req.Body != nil {
+				req.Body.Close()
+			}
+			return nil, err
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+type unlimitedKey struct{}
+
+// withoutRateLimit exempts requests made with ctx from the rate limit. The
+// Socket.IO read loop uses it: its polls and pongs keep the session alive
+// and are not API calls.
+func withoutRateLimit(ctx context.Context) context.Context {
+	return context.WithValue(ctx, unlimitedKey{}, true)
+}
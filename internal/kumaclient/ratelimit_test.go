@@ -0,0 +1,158 @@
+package kumaclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// arrivals is a REST bridge stand-in that records when each request arrives.
+// GET /monitors/1 fails with 503 the first failures times.
+type arrivals struct {
+	mu       sync.Mutex
+	times    []time.Time
+	failures int
+}
+
+func (a *arrivals) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	a.mu.Lock()
+	a.times = append(a.times, time.Now())
+	fail := r.URL.Path == "/monitors/1" && a.failures > 0
+	if fail {
+		a.failures--
+	}
+	a.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.URL.Path == "/login/access-token":
+		_, _ = io.WriteString(w, `{"access_token":"abc","token_type":"bearer"}`)
+	case fail:
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, _ = io.WriteString(w, `{"detail":"busy"}`)
+	case r.URL.Path == "/monitors/1":
+		_, _ = io.WriteString(w, `{"monitor":{"id":1,"name":"api","type":"http"}}`)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// span returns the time between the first and the last request.
+func (a *arrivals) span(t *testing.T, want int) time.Duration {
+	t.Helper()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if len(a.times) != want {
+		t.Fatalf("server saw %d requests, want %d", len(a.times), want)
+	}
+
+	return a.times[len(a.times)-1].Sub(a.times[0])
+}
+
+const testRequestsPerSecond = 20 // one request every 50ms
+
+// minSpan is the shortest time n rate limited requests can take, less some
+// slack for the first request's own latency.
+func minSpan(n int) time.Duration {
+	return time.Duration(n-1) * time.Second / testRequestsPerSecond * 8 / 10
+}
+
+func newRateLimitedClient(t *testing.T, a *arrivals) *Client {
+	t.Helper()
+
+	srv := httptest.NewServer(a)
+	t.Cleanup(srv.Close)
+
+	c, err := New(context.Background(), Config{
+		Host:              srv.URL,
+		Username:          "admin",
+		Password:          "hunter2",
+		MaxRetries:        3,
+		RetryWaitMin:      time.Millisecond,
+		RequestsPerSecond: testRequestsPerSecond,
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	return c
+}
+
+// Retries back off by only a millisecond here; the limiter must still space
+// them, along with the login.
+func TestRateLimitThrottlesRetries(t *testing.T) {
+	a := &arrivals{failures: 2}
+	c := newRateLimitedClient(t, a)
+
+	if _, err := c.GetMonitor(context.Background(), 1); err != nil {
+		t.Fatalf("GetMonitor: %v", err)
+	}
+
+	// The login, two failed attempts and the successful one.
+	if got, want := a.span(t, 4), minSpan(4); got < want {
+		t.Errorf("4 requests took %s, want at least %s", got, want)
+	}
+}
+
+// Resources share the client, so concurrent calls share its limiter.
+func TestRateLimitSharedByConcurrentCalls(t *testing.T) {
+	a := &arrivals{}
+	c := newRateLimitedClient(t, a)
+
+	const calls = 5
+	var wg sync.WaitGroup
+	for i := 0; i < calls; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := c.GetMonitor(context.Background(), 1); err != nil {
+				t.Errorf("GetMonitor: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got, want := a.span(t, calls+1), minSpan(calls+1); got < want {
+		t.Errorf("%d requests took %s, want at least %s", calls+1, got, want)
+	}
+}
+
+func TestRateLimitTransportExemptsContext(t *testing.T) {
+	calls := 0
+	base := roundTripFunc(func(*http.Request) (*http.Response, error) {
+		calls++
+		return statusResponse(http.StatusOK), nil
+	})
+	// One request per hour: only the first may go through without waiting.
+	rt := newRateLimitTransport(base, newRateLimiter(1.0/3600))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	for i := 0; i < 3; i++ {
+		req, _ := http.NewRequestWithContext(withoutRateLimit(ctx), http.MethodGet, "http://kuma.test/socket.io/", nil)
+		if _, err := rt.RoundTrip(req); err != nil {
+			t.Fatalf("exempt request %d: %v", i, err)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "http://kuma.test/monitors", nil)
+		_, err := rt.RoundTrip(req)
+		if i == 0 && err != nil {
+			t.Fatalf("first request: %v", err)
+		}
+		if i == 1 && err == nil {
+			t.Fatal("second request was not held back by the limiter")
+		}
+	}
+	if calls != 4 {
+		t.Errorf("calls = %d, want 4", calls)
+	}
+}
@@ -0,0 +1,157 @@
+package kumaclient
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces secret values in log output.
+const Redacted = "***"
+
+// sensitiveKeyParts are matched against object keys, form fields and header
+// names after lower-casing them and dropping "_" and "-", so both Uptime
+// Kuma's camelCase keys and the provider's snake_case attributes match.
+var sensitiveKeyParts = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"authpass",
+	"secret",
+	"token",
+	"apikey",
+	"authorization",
+	"cookie",
+	"connectionstring",
+	"tlskey",
+	"privatekey",
+	"clientkey",
+	"sasl",
+	"webhookurl",
+	"additionalheaders",
+	"integrationkey",
+	"userkey",
+}
+
+// nonSensitiveKeys match sensitiveKeyParts but hold no secret.
+var nonSensitiveKeys = map[string]bool{
+	"oauthtokenurl": true,
+	"tokenrequired": true,
+}
+
+// IsSensitiveKey reports whether values stored under key should be kept out
+// of logs.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	key = strings.NewReplacer("_", "", "-", "").Replace(key)
+	if nonSensitiveKeys[key] {
+		return false
+	}
+
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RedactedJSON marshals v to JSON with the values of sensitive keys replaced
+// by Redacted. It is meant for debug logging, so errors are reported inline.
+func RedactedJSON(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "<unable to encode: " + err.Error() + ">"
+	}
+
+	return redactBody(data)
+}
+
+// redactBody masks secrets in a request or response body, which may be JSON,
+// a form, or a Socket.IO polling payload with JSON embedded in each packet.
+func redactBody(data []byte) string {
+	var parsed any
+	if json.Unmarshal(data, &parsed) == nil {
+		out, err := json.Marshal(redactValue(parsed))
+		if err == nil {
+			return string(out)
+		}
+	}
+
+	text := string(data)
+	if strings.Contains(text, "=") && !strings.ContainsAny(text, "{[\"") {
+		if form, err := url.ParseQuery(text); err == nil {
+			for k := range form {
+				if IsSensitiveKey(k) {
+					form[k] = []string{Redacted}
+				}
+			}
+			return form.Encode()
+		}
+	}
+
+	return redactText(text)
+}
+
+// redactValue walks a decoded JSON value and masks sensitive keys.
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if IsSensitiveKey(k) {
+				switch val.(type) {
+				case nil, bool:
+				default:
+					if val != "" {
+						v[k] = Redacted
+					}
+				}
+				continue
+			}
+			v[k] = redactValue(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = redactValue(val)
+		}
+	}
+
+	return v
+}
+
+// jsonStringField matches "key":"value" pairs in text that is not valid JSON
+// as a whole, such as Socket.IO packets.
+var jsonStringField = regexp.MustCompile(`"([^"\\]+)"\s*:\s*"((?:[^"\\]|\\.)*)"`)
+
+// jwtPattern matches JSON web tokens, which Uptime Kuma passes positionally
+// in loginByToken rather than under a key.
+var jwtPattern = regexp.MustCompile(`eyJ[\w-]+\.[\w-]+\.[\w-]+`)
+
+func redactText(s string) string {
+	s = jwtPattern.ReplaceAllString(s, Redacted)
+
+	return jsonStringField.ReplaceAllStringFunc(s, func(m string) string {
+		sub := jsonStringField.FindStringSubmatch(m)
+		if !IsSensitiveKey(sub[1]) || sub[2] == "" {
+			return m
+		}
+		return `"` + sub[1] + `":"` + Redacted + `"`
+	})
+}
+
+// redactHeaders returns h as a flat map with sensitive headers masked.
+func redactHeaders(h http.Header) map[string]string {
+	out := make(map[string]string, len(h))
+	for k, v := range h {
+		if IsSensitiveKey(k) {
+			out[k] = Redacted
+			continue
+		}
+		out[k] = strings.Join(v, ", ")
+	}
+
+	return out
+}
@@ -0,0 +1,56 @@
+package kumaclient
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsSensitiveKey(t *testing.T) {
+	tests := map[string]bool{
+		"password":                   true,
+		"basic_auth_pass":            true,
+		"mqttPassword":               true,
+		"radius_secret":              true,
+		"access_token":               true,
+		"Authorization":              true,
+		"database_connection_string": true,
+		"tls_key":                    true,
+		"slackwebhookURL":            true,
+		"webhook_url":                true,
+		"webhookAdditionalHeaders":   true,
+		"pagerdutyIntegrationKey":    true,
+		"pushoveruserkey":            true,
+
+		"name":                false,
+		"oauth_token_url":     false,
+		"webhookContentType":  false,
+		"webhookCustomBody":   false,
+		"passive":             false,
+		"bypass_cache":        false,
+		"expiry_notification": false,
+	}
+
+	for key, want := range tests {
+		if got := IsSensitiveKey(key); got != want {
+			t.Errorf("IsSensitiveKey(%q) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestRedactedJSON(t *testing.T) {
+	got := RedactedJSON(map[string]any{
+		"name":            "api",
+		"basic_auth_pass": "hunter2",
+		"active":          true,
+		"nested":          map[string]any{"token": "abc"},
+	})
+
+	for _, secret := range []string{"hunter2", "abc"} {
+		if strings.Contains(got, secret) {
+			t.Errorf("RedactedJSON leaked %q: %s", secret, got)
+		}
+	}
+	if !strings.Contains(got, `"name":"api"`) {
+		t.Errorf("RedactedJSON masked a harmless field: %s", got)
+	}
+}
@@ -0,0 +1,459 @@
+package kumaclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/carlmjohnson/requests"
+)
+
+// restBackend talks to the medaziz11/uptimekuma_restapi bridge, which wraps
+// Uptime Kuma's Socket.IO API in a FastAPI service.
+type restBackend struct {
+	host     string
+	username string
+	password string
+	totp     string
+	apiKey   string
+	http     *http.Client
+
+	mu    sync.RWMutex
+	token string
+}
+
+func newRESTBackend(cfg Config) *restBackend {
+	return &restBackend{
+		host:     cfg.Host,
+		username: cfg.Username,
+		password: cfg.Password,
+		totp:     cfg.TOTPSecret,
+		apiKey:   cfg.APIKey,
+		http:     cfg.HTTPClient,
+		token:    cfg.Token,
+	}
+}
+
+type loginResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+}
+
+func (b *restBackend) connect(ctx context.Context) error {
+	if b.apiKey != "" || b.token != "" {
+		return nil
+	}
+
+	return b.login(ctx)
+}
+
+func (b *restBackend) login(ctx context.Context) error {
+	if b.username == "" || b.password == "" {
+		return &Error{
+			Op:  "login",
+			Err: fmt.Errorf("%w: the configured token or api key was rejected and no username and password are set to log in again", ErrUnauthorized),
+		}
+	}
+
+	form := url.Values{
+		"username": {b.username},
+		"password": {b.password},
+	}
+
+	code, err := currentTOTP(b.totp)
+	if err != nil {
+		return &Error{Op: "login", Err: err}
+	}
+	if code != "" {
+		form.Set("token", code)
+	}
+
+	var resp loginResponse
+	err = b.fetch(ctx, "login",
+		requests.
+			URL(b.host).
+			Client(b.http).
+			Path("/login/access-token").
+			BodyForm(form).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return loginError(err, code != "")
+	}
+
+	if resp.AccessToken == "" {
+		return &Error{Op: "login", Err: errors.New("server returned an empty access token")}
+	}
+	if resp.TokenType != "" && !strings.EqualFold(resp.TokenType, "bearer") {
+		return &Error{Op: "login", Err: fmt.Errorf("server returned an unsupported token type %q", resp.TokenType)}
+	}
+
+	b.mu.Lock()
+	b.token = resp.AccessToken
+	b.mu.Unlock()
+
+	return nil
+}
+
+// loginError classifies a failed login. FastAPI answers bad credentials with
+// 400 rather than 401, and a host that points somewhere other than the bridge
+// usually answers with HTML.
+func loginError(err error, sentTOTP bool) error {
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		return err
+	}
+
+	switch {
+	case !sentTOTP && isTOTPRequiredMessage(apiErr.Message):
+		apiErr.Err = ErrTOTPRequired
+	case apiErr.StatusCode == http.StatusBadRequest,
+		apiErr.StatusCode == http.StatusUnauthorized,
+		apiErr.StatusCode == http.StatusForbidden:
+		apiErr.Err = ErrUnauthorized
+	}
+
+	var syntaxErr *json.SyntaxError
+	if apiErr.StatusCode == 0 && errors.As(err, &syntaxErr) {
+		apiErr.Err = fmt.Errorf("response is not JSON, check that host points at the REST bridge: %w", apiErr.Err)
+	}
+
+	return apiErr
+}
+
+func (b *restBackend) close() error {
+	return nil
+}
+
+// request starts an authenticated request against the bridge. API keys go
+// in the password of HTTP basic auth, the same way Uptime Kuma accepts them.
+func (b *restBackend) request(format string, a ...any) *requests.Builder {
+	rb := requests.
+		URL(b.host).
+		Client(b.http).
+		Pathf(format, a...)
+
+	if b.apiKey != "" {
+		return rb.BasicAuth("", b.apiKey)
+	}
+
+	b.mu.RLock()
+	token := b.token
+	b.mu.RUnlock()
+
+	return rb.Bearer(token)
+}
+
+// fetch runs rb and converts any failure into an *Error tagged with op.
+func (b *restBackend) fetch(ctx context.Context, op string, rb *requests.Builder) error {
+	var body string
+	err := rb.
+		AddValidator(requests.ValidatorHandler(requests.DefaultValidator, requests.ToString(&body))).
+		Fetch(ctx)
+	if err == nil {
+		return nil
+	}
+
+	apiErr := &Error{Op: op, Err: err}
+
+	var respErr *requests.ResponseError
+	if errors.As(err, &respErr) {
+		apiErr.StatusCode = respErr.StatusCode
+		apiErr.Message = errorMessage(body)
+	}
+
+	return apiErr
+}
+
+// Monitors are decoded through fromKumaMonitor, as the bridge passes on
+// Uptime Kuma's own representation of some fields.
+type monitorResponse struct {
+	Monitor map[string]any `json:"monitor"`
+}
+
+type monitorsResponse struct {
+	Monitors []map[string]any `json:"monitors"`
+}
+
+// monitorMutationResponse is returned by the create and edit endpoints.
+type monitorMutationResponse struct {
+	Msg       string `json:"msg"`
+	MonitorID int64  `json:"monitorID"`
+}
+
+func (b *restBackend) listMonitors(ctx context.Context) ([]Monitor, error) {
+	var resp monitorsResponse
+	err := b.fetch(ctx, "list monitors",
+		b.request("/monitors").
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	monitors := make([]Monitor, 0, len(resp.Monitors))
+	for _, km := range resp.Monitors {
+		m, err := fromKumaMonitor(km)
+		if err != nil {
+			return nil, &Error{Op: "list monitors", Err: err}
+		}
+		monitors = append(monitors, *m)
+	}
+
+	return monitors, nil
+}
+
+func (b *restBackend) getMonitor(ctx context.Context, id int64) (*Monitor, error) {
+	op := fmt.Sprintf("get monitor %d", id)
+
+	var resp monitorResponse
+	err := b.fetch(ctx, op,
+		b.request("/monitors/%d", id).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Monitor == nil {
+		return nil, &Error{Op: op, Err: ErrNotFound}
+	}
+
+	m, err := fromKumaMonitor(resp.Monitor)
+	if err != nil {
+		return nil, &Error{Op: op, Err: err}
+	}
+
+	return m, nil
+}
+
+func (b *restBackend) createMonitor(ctx context.Context, m *Monitor) (int64, error) {
+	var resp monitorMutationResponse
+	err := b.fetch(ctx, "create monitor",
+		b.request("/monitors").
+			BodyJSON(m).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.MonitorID, nil
+}
+
+func (b *restBackend) updateMonitor(ctx context.Context, id int64, m *Monitor) error {
+	return b.fetch(ctx, fmt.Sprintf("update monitor %d", id),
+		b.request("/monitors/%d", id).
+			Patch().
+			BodyJSON(m),
+	)
+}
+
+func (b *restBackend) deleteMonitor(ctx context.Context, id int64) error {
+	return b.fetch(ctx, fmt.Sprintf("delete monitor %d", id),
+		b.request("/monitors/%d", id).
+			Delete(),
+	)
+}
+
+type tagResponse struct {
+	Tag Tag `json:"tag"`
+}
+
+type tagsResponse struct {
+	Tags []Tag `json:"tags"`
+}
+
+func (b *restBackend) listTags(ctx context.Context) ([]Tag, error) {
+	var resp tagsResponse
+	err := b.fetch(ctx, "list tags",
+		b.request("/tags").
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Tags, nil
+}
+
+func (b *restBackend) getTag(ctx context.Context, id int64) (*Tag, error) {
+	var resp tagResponse
+	err := b.fetch(ctx, fmt.Sprintf("get tag %d", id),
+		b.request("/tags/%d", id).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Tag, nil
+}
+
+// tagRequest is the body of the tag create and edit endpoints.
+type tagRequest struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+func (b *restBackend) createTag(ctx context.Context, t *Tag) (*Tag, error) {
+	var created Tag
+	err := b.fetch(ctx, "create tag",
+		b.request("/tags").
+			BodyJSON(tagRequest{Name: t.Name, Color: t.Color}).
+			ToJSON(&created),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (b *restBackend) updateTag(ctx context.Context, id int64, t *Tag) error {
+	return b.fetch(ctx, fmt.Sprintf("update tag %d", id),
+		b.request("/tags/%d", id).
+			Patch().
+			BodyJSON(tagRequest{Name: t.Name, Color: t.Color}),
+	)
+}
+
+func (b *restBackend) deleteTag(ctx context.Context, id int64) error {
+	return b.fetch(ctx, fmt.Sprintf("delete tag %d", id),
+		b.request("/tags/%d", id).
+			Delete(),
+	)
+}
+
+// monitorTagRequest is the body of the monitor tag endpoints.
+type monitorTagRequest struct {
+	TagID int64  `json:"tag_id"`
+	Value string `json:"value"`
+}
+
+func (b *restBackend) addMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	return b.fetch(ctx, fmt.Sprintf("add tag %d to monitor %d", tagID, monitorID),
+		b.request("/monitors/%d/tag", monitorID).
+			BodyJSON(monitorTagRequest{TagID: tagID, Value: value}),
+	)
+}
+
+func (b *restBackend) deleteMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	return b.fetch(ctx, fmt.Sprintf("delete tag %d from monitor %d", tagID, monitorID),
+		b.request("/monitors/%d/tag", monitorID).
+			Delete().
+			BodyJSON(monitorTagRequest{TagID: tagID, Value: value}),
+	)
+}
+
+func (b *restBackend) listUsers(ctx context.Context) ([]User, error) {
+	var users []User
+	err := b.fetch(ctx, "list users",
+		b.request("/users/").
+			ToJSON(&users),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (b *restBackend) getUser(ctx context.Context, username string) (*User, error) {
+	var user User
+	err := b.fetch(ctx, fmt.Sprintf("get user %q", username),
+		b.request("/users/%s", username).
+			ToJSON(&user),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+type notificationResponse struct {
+	Notification Notification `json:"notification"`
+}
+
+type notificationsResponse struct {
+	Notifications []Notification `json:"notifications"`
+}
+
+func (b *restBackend) listNotifications(ctx context.Context) ([]Notification, error) {
+	var resp notificationsResponse
+	err := b.fetch(ctx, "list notifications",
+		b.request("/notifications").
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Notifications, nil
+}
+
+func (b *restBackend) getNotification(ctx context.Context, id int64) (*Notification, error) {
+	var resp notificationResponse
+	err := b.fetch(ctx, fmt.Sprintf("get notification %d", id),
+		b.request("/notifications/%d", id).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp.Notification, nil
+}
+
+// notificationMutationResponse is returned by the create endpoint.
+type notificationMutationResponse struct {
+	Msg string `json:"msg"`
+	ID  int64  `json:"id"`
+}
+
+func (b *restBackend) createNotification(ctx context.Context, n *Notification) (int64, error) {
+	var resp notificationMutationResponse
+	err := b.fetch(ctx, "create notification",
+		b.request("/notifications").
+			BodyJSON(n).
+			ToJSON(&resp),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	return resp.ID, nil
+}
+
+func (b *restBackend) updateNotification(ctx context.Context, id int64, n *Notification) error {
+	return b.fetch(ctx, fmt.Sprintf("update notification %d", id),
+		b.request("/notifications/%d", id).
+			Patch().
+			BodyJSON(n),
+	)
+}
+
+func (b *restBackend) deleteNotification(ctx context.Context, id int64) error {
+	return b.fetch(ctx, fmt.Sprintf("delete notification %d", id),
+		b.request("/notifications/%d", id).
+			Delete(),
+	)
+}
+
+func (b *restBackend) serverInfo(ctx context.Context) (*ServerInfo, error) {
+	var info ServerInfo
+	err := b.fetch(ctx, "get server info",
+		b.request("/info/").
+			ToJSON(&info),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &info, nil
+}
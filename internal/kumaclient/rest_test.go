@@ -0,0 +1,234 @@
+package kumaclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// loginServer starts a REST bridge stand-in whose login endpoint answers
+// with status and body.
+func loginServer(t *testing.T, status int, contentType, body string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/login/access-token" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func newTestRESTClient(host string) (*Client, error) {
+	return New(context.Background(), Config{
+		Host:     host,
+		Username: "admin",
+		Password: "hunter2",
+	})
+}
+
+func TestRESTLogin(t *testing.T) {
+	var form map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		form = map[string]string{
+			"username": r.PostForm.Get("username"),
+			"password": r.PostForm.Get("password"),
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"abc","token_type":"bearer"}`))
+	}))
+	defer srv.Close()
+
+	c, err := newTestRESTClient(srv.URL)
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if form["username"] != "admin" || form["password"] != "hunter2" {
+		t.Errorf("login sent form %v", form)
+	}
+	if got := c.backend.(*restBackend).token; got != "abc" {
+		t.Errorf("token = %q, want %q", got, "abc")
+	}
+}
+
+func TestRESTLoginErrors(t *testing.T) {
+	tests := map[string]struct {
+		status      int
+		contentType string
+		body        string
+		check       func(error) bool
+	}{
+		"non-200 status": {
+			status:      http.StatusInternalServerError,
+			contentType: "application/json",
+			body:        `{"detail":"Internal Server Error"}`,
+			check: func(err error) bool {
+				var apiErr *Error
+				return errors.As(err, &apiErr) &&
+					apiErr.StatusCode == http.StatusInternalServerError &&
+					!errors.Is(err, ErrUnauthorized)
+			},
+		},
+		"400 bad credentials": {
+			status:      http.StatusBadRequest,
+			contentType: "application/json",
+			body:        `{"detail":"Incorrect username or password"}`,
+			check: func(err error) bool {
+				return errors.Is(err, ErrUnauthorized) && !errors.Is(err, ErrTOTPRequired)
+			},
+		},
+		"HTML body": {
+			status:      http.StatusOK,
+			contentType: "text/html",
+			body:        `<!DOCTYPE html><html><body>Uptime Kuma</body></html>`,
+			check: func(err error) bool {
+				return strings.Contains(err.Error(), "response is not JSON")
+			},
+		},
+		"empty access_token": {
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"access_token":"","token_type":"bearer"}`,
+			check: func(err error) bool {
+				return strings.Contains(err.Error(), "empty access token")
+			},
+		},
+		"TOTP required": {
+			status:      http.StatusBadRequest,
+			contentType: "application/json",
+			body:        `{"detail":"tokenRequired"}`,
+			check: func(err error) bool {
+				return errors.Is(err, ErrTOTPRequired)
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := loginServer(t, tt.status, tt.contentType, tt.body)
+
+			_, err := newTestRESTClient(srv.URL)
+			if err == nil {
+				t.Fatal("New succeeded, want a login error")
+			}
+			if !tt.check(err) {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRESTLoginConnectionRefused(t *testing.T) {
+	srv := httptest.NewServer(http.NotFoundHandler())
+	host := srv.URL
+	srv.Close()
+
+	_, err := newTestRESTClient(host)
+	if err == nil {
+		t.Fatal("New succeeded, want a connection error")
+	}
+	if !IsConnectionError(err) {
+		t.Errorf("IsConnectionError(%v) = false, want true", err)
+	}
+	if errors.Is(err, ErrUnauthorized) {
+		t.Errorf("connection error %v matches ErrUnauthorized", err)
+	}
+}
+
+func TestRESTLoginSendsTOTP(t *testing.T) {
+	var token string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		token = r.PostForm.Get("token")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"access_token":"abc","token_type":"bearer"}`))
+	}))
+	defer srv.Close()
+
+	_, err := New(context.Background(), Config{
+		Host:       srv.URL,
+		Username:   "admin",
+		Password:   "hunter2",
+		TOTPSecret: "JBSWY3DPEHPK3PXP",
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	if len(token) != totpDigits {
+		t.Errorf("login sent token %q, want a %d digit code", token, totpDigits)
+	}
+}
+
+// A rejected code must not be reported as a missing one.
+func TestRESTLoginTOTPRejected(t *testing.T) {
+	srv := loginServer(t, http.StatusBadRequest, "application/json", `{"detail":"tokenRequired"}`)
+
+	_, err := New(context.Background(), Config{
+		Host:       srv.URL,
+		Username:   "admin",
+		Password:   "hunter2",
+		TOTPSecret: "JBSWY3DPEHPK3PXP",
+	})
+	if errors.Is(err, ErrTOTPRequired) {
+		t.Errorf("error %v matches ErrTOTPRequired although a code was sent", err)
+	}
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("error %v does not match ErrUnauthorized", err)
+	}
+}
+
+func TestRESTMonitorNotificationIDs(t *testing.T) {
+	var sent map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/monitors" && r.Method == http.MethodPost:
+			_ = json.NewDecoder(r.Body).Decode(&sent)
+			_, _ = io.WriteString(w, `{"msg":"Added Successfully.","monitorID":1}`)
+		case r.URL.Path == "/monitors":
+			_, _ = io.WriteString(w, `{"monitors":[{"id":1,"name":"api","type":"http","notification_id_list":[4,1]}]}`)
+		case r.URL.Path == "/monitors/1":
+			_, _ = io.WriteString(w, `{"monitor":{"id":1,"name":"api","type":"http","notification_id_list":{"1":true,"4":true,"7":false}}}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(context.Background(), Config{Host: srv.URL, APIKey: "key"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	m, err := c.CreateMonitor(context.Background(), &Monitor{Type: "http", Name: "api", NotificationIDList: []int64{1, 4}})
+	if err != nil {
+		t.Fatalf("CreateMonitor: %v", err)
+	}
+	if want := []any{float64(1), float64(4)}; !reflect.DeepEqual(sent["notification_id_list"], want) {
+		t.Errorf("sent notification_id_list %v, want %v", sent["notification_id_list"], want)
+	}
+	if want := []int64{1, 4}; !reflect.DeepEqual(m.NotificationIDList, want) {
+		t.Errorf("GetMonitor NotificationIDList = %v, want %v", m.NotificationIDList, want)
+	}
+
+	monitors, err := c.ListMonitors(context.Background())
+	if err != nil {
+		t.Fatalf("ListMonitors: %v", err)
+	}
+	if len(monitors) != 1 || !reflect.DeepEqual(monitors[0].NotificationIDList, []int64{1, 4}) {
+		t.Errorf("ListMonitors = %+v, want one monitor with notifications [1 4]", monitors)
+	}
+}
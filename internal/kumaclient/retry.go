@@ -0,0 +1,168 @@
+package kumaclient
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Defaults used by the provider when the retry settings are not configured.
+const (
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
+)
+
+// retryTransport retries failed requests with exponential backoff.
+//
+// GET, HEAD, PUT, DELETE and OPTIONS are idempotent and are retried on any
+// transport error, on 5xx responses and on 429. Other methods (POST, PATCH)
+// may already have taken effect when a response is lost, so they are only
+// retried when the connection could not be established at all. That includes
+// 429: the REST bridge may have applied the request before throttling.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+}
+
+func newRetryTransport(base http.RoundTripper, cfg Config) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if cfg.MaxRetries <= 0 {
+		return base
+	}
+
+	t := &retryTransport{
+		base:       base,
+		maxRetries: cfg.MaxRetries,
+		waitMin:    cfg.RetryWaitMin,
+		waitMax:    cfg.RetryWaitMax,
+	}
+	if t.waitMin <= 0 {
+		t.waitMin = DefaultRetryWaitMin
+	}
+	if t.waitMax < t.waitMin {
+		t.waitMax = t.waitMin
+	}
+
+	return t
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 && hasBody(req) {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !t.shouldRetry(req.Method, resp, err) {
+			return resp, err
+		}
+		if hasBody(req) && req.GetBody == nil {
+			// The body was consumed and cannot be replayed; give up with
+			// this result rather than resend a broken request.
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (t *retryTransport) shouldRetry(method string, resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return isIdempotent(method) || isConnectError(err)
+	}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		return isIdempotent(method)
+	}
+
+	return isIdempotent(method) && resp.StatusCode >= 500 && resp.StatusCode != http.StatusNotImplemented
+}
+
+// backoff returns how long to wait before the next attempt: the server's
+// Retry-After if it sent one, otherwise exponential backoff with jitter.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && s >= 0 {
+			wait := time.Duration(s) * time.Second
+			if wait > t.waitMax {
+				wait = t.waitMax
+			}
+			return wait
+		}
+	}
+
+	wait := t.waitMin << attempt
+	if wait <= 0 || wait > t.waitMax {
+		wait = t.waitMax
+	}
+
+	// Jitter in [wait/2, wait) keeps parallel resources from retrying
+	// in lockstep.
+	half := int64(wait / 2)
+	if half > 0 {
+		wait = time.Duration(half + rand.Int63n(half))
+	}
+
+	return wait
+}
+
+func hasBody(req *http.Request) bool {
+	return req.Body != nil && req.Body != http.NoBody
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+// isConnectError reports whether err happened before the request could be
+// delivered, which makes retrying safe for any method.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	return errors.Is(err, syscall.ECONNREFUSED)
+}
@@ -0,0 +1,122 @@
+package kumaclient
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// roundTripFunc adapts a function to http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func statusResponse(code int) *http.Response {
+	return &http.Response{
+		StatusCode: code,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+}
+
+func TestRetryTransportReplaysBody(t *testing.T) {
+	var bodies []string
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		data, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(data))
+		if len(bodies) < 3 {
+			return statusResponse(http.StatusServiceUnavailable), nil
+		}
+		return statusResponse(http.StatusOK), nil
+	})
+	rt := newRetryTransport(base, Config{MaxRetries: 3, RetryWaitMin: time.Millisecond})
+
+	req, _ := http.NewRequest(http.MethodPut, "http://kuma.test/monitors/1", strings.NewReader(`{"name":"a"}`))
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("status = %d, want 200", resp.StatusCode)
+	}
+	if len(bodies) != 3 {
+		t.Fatalf("sent %d requests, want 3", len(bodies))
+	}
+	for i, b := range bodies {
+		if b != `{"name":"a"}` {
+			t.Errorf("attempt %d sent body %q", i, b)
+		}
+	}
+}
+
+func TestRetryTransportNonRewindableBody(t *testing.T) {
+	calls := 0
+	first := statusResponse(http.StatusServiceUnavailable)
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		_, _ = io.ReadAll(req.Body)
+		return first, nil
+	})
+	rt := newRetryTransport(base, Config{MaxRetries: 3, RetryWaitMin: time.Millisecond})
+
+	req, _ := http.NewRequest(http.MethodPut, "http://kuma.test/monitors/1", io.NopCloser(strings.NewReader(`{"name":"a"}`)))
+	if req.GetBody != nil {
+		t.Fatal("test request unexpectedly has GetBody")
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("sent %d requests, want 1", calls)
+	}
+	if resp != first {
+		t.Error("did not return the response of the only attempt")
+	}
+}
+
+func TestRetryTransportPostOnlyRetriesConnectErrors(t *testing.T) {
+	for _, status := range []int{http.StatusBadGateway, http.StatusTooManyRequests} {
+		calls := 0
+		base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return statusResponse(status), nil
+		})
+		rt := newRetryTransport(base, Config{MaxRetries: 3, RetryWaitMin: time.Millisecond})
+
+		req, _ := http.NewRequest(http.MethodPost, "http://kuma.test/monitors", strings.NewReader(`{}`))
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip: %v", err)
+		}
+		if calls != 1 || resp.StatusCode != status {
+			t.Errorf("calls = %d, status = %d; want 1 call returning %d", calls, resp.StatusCode, status)
+		}
+	}
+}
+
+func TestRetryTransportRetriesGetOnTooManyRequests(t *testing.T) {
+	calls := 0
+	base := roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		calls++
+		if calls == 1 {
+			return statusResponse(http.StatusTooManyRequests), nil
+		}
+		return statusResponse(http.StatusOK), nil
+	})
+	rt := newRetryTransport(base, Config{MaxRetries: 3, RetryWaitMin: time.Millisecond})
+
+	req, _ := http.NewRequest(http.MethodGet, "http://kuma.test/monitors", nil)
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+	if calls != 2 || resp.StatusCode != http.StatusOK {
+		t.Errorf("calls = %d, status = %d; want 2 calls ending in 200", calls, resp.StatusCode)
+	}
+}
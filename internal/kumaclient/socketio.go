@@ -0,0 +1,444 @@
+package kumaclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// This file implements just enough of the Engine.IO v4 / Socket.IO v5
+// protocol to talk to Uptime Kuma: the HTTP long-polling transport, the
+// default namespace, events and acknowledgements. Polling keeps every
+// request on the plain *http.Client, so proxies and TLS settings apply the
+// same way they do for the REST bridge.
+
+// Engine.IO packet types.
+const (
+	eioOpen    = '0'
+	eioClose   = '1'
+	eioPing    = '2'
+	eioPong    = '3'
+	eioMessage = '4'
+	eioNoop    = '6'
+)
+
+// Socket.IO packet types.
+const (
+	sioConnect      = '0'
+	sioDisconnect   = '1'
+	sioEvent        = '2'
+	sioAck          = '3'
+	sioConnectError = '4'
+)
+
+// eioRecordSeparator separates packets in a polling payload.
+const eioRecordSeparator = "\x1e"
+
+var errSocketClosed = errors.New("socket.io connection closed")
+
+// socketConn is a single Socket.IO session over HTTP long-polling.
+type socketConn struct {
+	http     *http.Client
+	endpoint string
+
+	// timeout bounds the handshake and every POST. Long-polling GETs are
+	// left open for as long as the server holds them.
+	timeout time.Duration
+
+	sid string
+
+	mu      sync.Mutex
+	nextAck int
+	acks    map[int]chan json.RawMessage
+	events  map[string]json.RawMessage
+	changed chan struct{} // closed and replaced whenever events changes
+	err     error         // set once the session is gone
+
+	connected chan struct{}
+	done      chan struct{}
+	cancel    context.CancelFunc
+}
+
+type eioHandshake struct {
+	SID          string `json:"sid"`
+	PingInterval int    `json:"pingInterval"`
+	PingTimeout  int    `json:"pingTimeout"`
+}
+
+// dialSocket opens a Socket.IO session against the server at host and joins
+// the default namespace.
+func dialSocket(ctx context.Context, client *http.Client, host string) (*socketConn, error) {
+	pollClient := *client
+	pollClient.Timeout = 0
+
+	conn := &socketConn{
+		http:      &pollClient,
+		endpoint:  host + "/socket.io/",
+		timeout:   client.Timeout,
+		acks:      map[int]chan json.RawMessage{},
+		events:    map[string]json.RawMessage{},
+		changed:   make(chan struct{}),
+		connected: make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	hsCtx, cancel := conn.withTimeout(ctx)
+	packets, err := conn.poll(hsCtx)
+	cancel()
+	if err != nil {
+		return nil, fmt.Errorf("socket.io handshake: %w", err)
+	}
+	if len(packets) == 0 || packets[0] == "" || packets[0][0] != eioOpen {
+		return nil, fmt.Errorf("socket.io handshake: unexpected response %q", strings.Join(packets, eioRecordSeparator))
+	}
+
+	var hs eioHandshake
+	if err := json.Unmarshal([]byte(packets[0][1:]), &hs); err != nil {
+		return nil, fmt.Errorf("socket.io handshake: %w", err)
+	}
+	conn.sid = hs.SID
+
+	if err := conn.send(ctx, string(eioMessage)+string(sioConnect)); err != nil {
+		return nil, fmt.Errorf("socket.io connect: %w", err)
+	}
+
+	loopCtx, cancel := context.WithCancel(withoutRateLimit(context.Background()))
+	conn.cancel = cancel
+	go conn.readLoop(loopCtx)
+
+	select {
+	case <-conn.connected:
+		return conn, nil
+	case <-conn.done:
+		return nil, fmt.Errorf("socket.io connect: %w", conn.closedErr())
+	case <-ctx.Done():
+		conn.close()
+		return nil, ctx.Err()
+	}
+}
+
+func (s *socketConn) url() string {
+	u := s.endpoint + "?EIO=4&transport=polling&t=" + strconv.FormatInt(time.Now().UnixNano(), 36)
+	if s.sid != "" {
+		u += "&sid=" + s.sid
+	}
+
+	return u
+}
+
+// poll performs one long-polling GET and returns the packets it carried.
+func (s *socketConn) poll(ctx context.Context) ([]string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, &Error{
+			Op:         "socket.io poll",
+			StatusCode: resp.StatusCode,
+			Message:    errorMessage(string(body)),
+			Err:        errSocketClosed,
+		}
+	}
+
+	return strings.Split(string(body), eioRecordSeparator), nil
+}
+
+// withTimeout applies the connection's request timeout to ctx.
+func (s *socketConn) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if s.timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+
+	return context.WithTimeout(ctx, s.timeout)
+}
+
+// send POSTs a single Engine.IO packet.
+func (s *socketConn) send(ctx context.Context, packet string) error {
+	ctx, cancel := s.withTimeout(ctx)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url(), bytes.NewBufferString(packet))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "text/plain;charset=UTF-8")
+
+	resp, err := s.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	if resp.StatusCode != http.StatusOK {
+		return &Error{
+			Op:         "socket.io send",
+			StatusCode: resp.StatusCode,
+			Message:    errorMessage(string(body)),
+			Err:        errSocketClosed,
+		}
+	}
+
+	return nil
+}
+
+// readLoop polls the server until the session ends, answering pings and
+// dispatching acknowledgements and events.
+func (s *socketConn) readLoop(ctx context.Context) {
+	var err error
+	defer func() {
+		s.shutdown(err)
+	}()
+
+	for {
+		var packets []string
+		packets, err = s.poll(ctx)
+		if err != nil {
+			return
+		}
+
+		for _, p := range packets {
+			if p == "" {
+				continue
+			}
+
+			switch p[0] {
+			case eioPing:
+				if err = s.send(ctx, string(eioPong)); err != nil {
+					return
+				}
+			case eioClose:
+				err = errSocketClosed
+				return
+			case eioMessage:
+				if err = s.handleMessage(p[1:]); err != nil {
+					return
+				}
+			case eioNoop, eioPong:
+			}
+		}
+	}
+}
+
+// handleMessage dispatches one Socket.IO packet.
+func (s *socketConn) handleMessage(p string) error {
+	if p == "" {
+		return nil
+	}
+
+	kind, rest := p[0], p[1:]
+
+	// Skip the namespace prefix; only the default namespace is used.
+	if strings.HasPrefix(rest, "/") {
+		if i := strings.IndexByte(rest, ','); i >= 0 {
+			rest = rest[i+1:]
+		}
+	}
+
+	switch kind {
+	case sioConnect:
+		select {
+		case <-s.connected:
+		default:
+			close(s.connected)
+		}
+	case sioConnectError:
+		return fmt.Errorf("socket.io connect refused: %s", errorMessage(rest))
+	case sioDisconnect:
+		return errSocketClosed
+	case sioAck:
+		id, payload := splitAckID(rest)
+		s.mu.Lock()
+		ch, ok := s.acks[id]
+		delete(s.acks, id)
+		s.mu.Unlock()
+		if ok {
+			ch <- json.RawMessage(payload)
+		}
+	case sioEvent:
+		_, payload := splitAckID(rest)
+		var args []json.RawMessage
+		if err := json.Unmarshal([]byte(payload), &args); err != nil || len(args) == 0 {
+			return nil
+		}
+		var name string
+		if err := json.Unmarshal(args[0], &name); err != nil {
+			return nil
+		}
+		var data json.RawMessage
+		if len(args) > 1 {
+			data = args[1]
+		}
+
+		s.mu.Lock()
+		s.events[name] = data
+		close(s.changed)
+		s.changed = make(chan struct{})
+		s.mu.Unlock()
+	}
+
+	return nil
+}
+
+// splitAckID separates the optional numeric ack id from a packet's payload.
+func splitAckID(p string) (int, string) {
+	i := 0
+	for i < len(p) && p[i] >= '0' && p[i] <= '9' {
+		i++
+	}
+	if i == 0 {
+		return -1, p
+	}
+
+	id, _ := strconv.Atoi(p[:i])
+	return id, p[i:]
+}
+
+// emit sends event with args and waits for the server's acknowledgement,
+// returning its first argument.
+func (s *socketConn) emit(ctx context.Context, event string, args ...any) (json.RawMessage, error) {
+	payload, err := json.Marshal(append([]any{event}, args...))
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan json.RawMessage, 1)
+
+	s.mu.Lock()
+	if s.err != nil {
+		s.mu.Unlock()
+		return nil, s.err
+	}
+	id := s.nextAck
+	s.nextAck++
+	s.acks[id] = ch
+	s.mu.Unlock()
+
+	packet := string(eioMessage) + string(sioEvent) + strconv.Itoa(id) + string(payload)
+	if err := s.send(ctx, packet); err != nil {
+		s.mu.Lock()
+		delete(s.acks, id)
+		s.mu.Unlock()
+		return nil, err
+	}
+
+	select {
+	case raw := <-ch:
+		var ackArgs []json.RawMessage
+		if err := json.Unmarshal(raw, &ackArgs); err != nil {
+			return nil, fmt.Errorf("decoding %s acknowledgement: %w", event, err)
+		}
+		if len(ackArgs) == 0 {
+			return nil, nil
+		}
+		return ackArgs[0], nil
+	case <-s.done:
+		return nil, s.closedErr()
+	case <-ctx.Done():
+		s.mu.Lock()
+		delete(s.acks, id)
+		s.mu.Unlock()
+		return nil, ctx.Err()
+	}
+}
+
+// event returns the most recent payload of a server-sent event, waiting for
+// the first one to arrive if necessary.
+func (s *socketConn) event(ctx context.Context, name string) (json.RawMessage, error) {
+	for {
+		s.mu.Lock()
+		data, ok := s.events[name]
+		changed := s.changed
+		s.mu.Unlock()
+		if ok {
+			return data, nil
+		}
+
+		select {
+		case <-changed:
+		case <-s.done:
+			return nil, s.closedErr()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// forgetEvent drops the cached payload of name so the next call to event
+// waits for a fresh one.
+func (s *socketConn) forgetEvent(name string) {
+	s.mu.Lock()
+	delete(s.events, name)
+	s.mu.Unlock()
+}
+
+func (s *socketConn) closedErr() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.err == nil {
+		return errSocketClosed
+	}
+
+	return s.err
+}
+
+func (s *socketConn) shutdown(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	// Whatever ended the session, it is gone: keep the cause but make it
+	// match errSocketClosed so the client logs in on a new one.
+	switch {
+	case err == nil || errors.Is(err, context.Canceled):
+		err = errSocketClosed
+	case !errors.Is(err, errSocketClosed):
+		err = fmt.Errorf("%w: %w", errSocketClosed, err)
+	}
+	s.err = err
+	close(s.done)
+}
+
+// close ends the session.
+func (s *socketConn) close() {
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	_ = s.send(ctx, string(eioClose))
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.shutdown(errSocketClosed)
+}
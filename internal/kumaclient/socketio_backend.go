@@ -0,0 +1,704 @@
+package kumaclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// socketBackend talks to Uptime Kuma directly over its Socket.IO API, the
+// same one the web UI uses.
+type socketBackend struct {
+	host     string
+	username string
+	password string
+	totp     string
+	http     *http.Client
+
+	mu    sync.Mutex
+	conn  *socketConn
+	token string
+}
+
+func newSocketBackend(cfg Config) *socketBackend {
+	return &socketBackend{
+		host:     cfg.Host,
+		username: cfg.Username,
+		password: cfg.Password,
+		totp:     cfg.TOTPSecret,
+		http:     cfg.HTTPClient,
+		token:    cfg.Token,
+	}
+}
+
+// kumaAck is the common shape of Uptime Kuma's event callbacks.
+type kumaAck struct {
+	OK  bool   `json:"ok"`
+	Msg string `json:"msg"`
+}
+
+type kumaLoginAck struct {
+	kumaAck
+	Token         string `json:"token"`
+	TokenRequired bool   `json:"tokenRequired"`
+}
+
+// connect opens the first session. Every session needs its own login, so
+// this is the same as login.
+func (b *socketBackend) connect(ctx context.Context) error {
+	return b.login(ctx)
+}
+
+// login opens a new session and authenticates it, trying the token first and
+// falling back to the username and password when the token is rejected.
+func (b *socketBackend) login(ctx context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn != nil {
+		b.conn.close()
+		b.conn = nil
+	}
+
+	conn, err := dialSocket(ctx, b.http, b.host)
+	if err != nil {
+		return &Error{Op: "login", Err: err}
+	}
+
+	if err := b.authenticate(ctx, conn); err != nil {
+		conn.close()
+		return err
+	}
+
+	b.conn = conn
+	return nil
+}
+
+// authenticate logs conn in. Callers hold b.mu.
+func (b *socketBackend) authenticate(ctx context.Context, conn *socketConn) error {
+	if b.token != "" {
+		_, err := emitLogin(ctx, conn, "loginByToken", b.token)
+		if err == nil || b.username == "" || b.password == "" {
+			return err
+		}
+	}
+
+	// Uptime Kuma calls the two-factor code "token" in the login payload.
+	code, err := currentTOTP(b.totp)
+	if err != nil {
+		return &Error{Op: "login", Err: err}
+	}
+
+	ack, err := emitLogin(ctx, conn, "login", map[string]any{
+		"username": b.username,
+		"password": b.password,
+		"token":    code,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Keep the session token so reconnects can skip the password.
+	if ack.Token != "" {
+		b.token = ack.Token
+	}
+
+	return nil
+}
+
+// emitLogin emits one of the login events and decodes its acknowledgement.
+func emitLogin(ctx context.Context, conn *socketConn, event string, arg any) (*kumaLoginAck, error) {
+	raw, err := conn.emit(ctx, event, arg)
+	if err != nil {
+		return nil, &Error{Op: "login", Err: err}
+	}
+
+	var ack kumaLoginAck
+	if err := json.Unmarshal(raw, &ack); err != nil {
+		return nil, &Error{Op: "login", Err: err}
+	}
+	if ack.TokenRequired {
+		return nil, &Error{Op: "login", Message: ack.Msg, Err: ErrTOTPRequired}
+	}
+	if !ack.OK {
+		return nil, &Error{Op: "login", Message: ack.Msg, Err: ErrUnauthorized}
+	}
+
+	return &ack, nil
+}
+
+func (b *socketBackend) close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn != nil {
+		b.conn.close()
+		b.conn = nil
+	}
+
+	return nil
+}
+
+func (b *socketBackend) session() (*socketConn, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn == nil {
+		return nil, errSocketClosed
+	}
+
+	return b.conn, nil
+}
+
+// sessionError wraps err for op, marking a lost session as ErrUnauthorized so
+// the client logs in again.
+func sessionError(op string, err error) error {
+	if errors.Is(err, errSocketClosed) {
+		return &Error{Op: op, Err: fmt.Errorf("%w: %w", ErrUnauthorized, err)}
+	}
+
+	return &Error{Op: op, Err: err}
+}
+
+// ackError converts a failed acknowledgement into an *Error.
+func ackError(op, msg string) error {
+	if strings.Contains(strings.ToLower(msg), "not logged in") {
+		return &Error{Op: op, Message: msg, Err: ErrUnauthorized}
+	}
+	if isNotFoundMessage(msg) {
+		return &Error{Op: op, Message: msg, Err: ErrNotFound}
+	}
+
+	return &Error{Op: op, Message: msg, Err: errors.New(msg)}
+}
+
+// call emits event and decodes the acknowledgement into out, which may be
+// nil. Acknowledgements with ok=false are turned into an *Error.
+func (b *socketBackend) call(ctx context.Context, op string, out any, event string, args ...any) error {
+	conn, err := b.session()
+	if err != nil {
+		return sessionError(op, err)
+	}
+
+	raw, err := conn.emit(ctx, event, args...)
+	if err != nil {
+		return sessionError(op, err)
+	}
+
+	var ack kumaAck
+	if err := json.Unmarshal(raw, &ack); err != nil {
+		return &Error{Op: op, Err: fmt.Errorf("decoding response: %w", err)}
+	}
+	if !ack.OK {
+		return ackError(op, ack.Msg)
+	}
+
+	if out != nil {
+		if err := json.Unmarshal(raw, out); err != nil {
+			return &Error{Op: op, Err: fmt.Errorf("decoding response: %w", err)}
+		}
+	}
+
+	return nil
+}
+
+// event returns the latest payload of a server-pushed event.
+func (b *socketBackend) event(ctx context.Context, op, name string) (json.RawMessage, error) {
+	conn, err := b.session()
+	if err != nil {
+		return nil, sessionError(op, err)
+	}
+
+	raw, err := conn.event(ctx, name)
+	if err != nil {
+		return nil, sessionError(op, err)
+	}
+
+	return raw, nil
+}
+
+// forget drops the cached payload of a server-pushed event ahead of a change,
+// so the next read waits for the list Uptime Kuma pushes in response.
+func (b *socketBackend) forget(name string) {
+	if conn, err := b.session(); err == nil {
+		conn.forgetEvent(name)
+	}
+}
+
+func (b *socketBackend) listMonitors(ctx context.Context) ([]Monitor, error) {
+	raw, err := b.event(ctx, "list monitors", "monitorList")
+	if err != nil {
+		return nil, err
+	}
+
+	var byID map[string]map[string]any
+	if err := json.Unmarshal(raw, &byID); err != nil {
+		return nil, &Error{Op: "list monitors", Err: err}
+	}
+
+	monitors := make([]Monitor, 0, len(byID))
+	for _, km := range byID {
+		m, err := fromKumaMonitor(km)
+		if err != nil {
+			return nil, &Error{Op: "list monitors", Err: err}
+		}
+		monitors = append(monitors, *m)
+	}
+	sort.Slice(monitors, func(i, j int) bool { return monitors[i].ID < monitors[j].ID })
+
+	return monitors, nil
+}
+
+func (b *socketBackend) getMonitor(ctx context.Context, id int64) (*Monitor, error) {
+	op := fmt.Sprintf("get monitor %d", id)
+
+	var resp struct {
+		Monitor map[string]any `json:"monitor"`
+	}
+	if err := b.call(ctx, op, &resp, "getMonitor", id); err != nil {
+		return nil, err
+	}
+	if resp.Monitor == nil {
+		return nil, &Error{Op: op, Err: ErrNotFound}
+	}
+
+	m, err := fromKumaMonitor(resp.Monitor)
+	if err != nil {
+		return nil, &Error{Op: op, Err: err}
+	}
+
+	return m, nil
+}
+
+type kumaMonitorAck struct {
+	MonitorID int64 `json:"monitorID"`
+}
+
+func (b *socketBackend) createMonitor(ctx context.Context, m *Monitor) (int64, error) {
+	km, err := toKumaMonitor(m)
+	if err != nil {
+		return 0, &Error{Op: "create monitor", Err: err}
+	}
+	delete(km, "id")
+
+	b.forget("monitorList")
+
+	var resp kumaMonitorAck
+	if err := b.call(ctx, "create monitor", &resp, "add", km); err != nil {
+		return 0, err
+	}
+
+	return resp.MonitorID, nil
+}
+
+func (b *socketBackend) updateMonitor(ctx context.Context, id int64, m *Monitor) error {
+	km, err := toKumaMonitor(m)
+	if err != nil {
+		return &Error{Op: fmt.Sprintf("update monitor %d", id), Err: err}
+	}
+	km["id"] = id
+
+	b.forget("monitorList")
+
+	return b.call(ctx, fmt.Sprintf("update monitor %d", id), nil, "editMonitor", km)
+}
+
+func (b *socketBackend) deleteMonitor(ctx context.Context, id int64) error {
+	b.forget("monitorList")
+
+	return b.call(ctx, fmt.Sprintf("delete monitor %d", id), nil, "deleteMonitor", id)
+}
+
+func (b *socketBackend) listTags(ctx context.Context) ([]Tag, error) {
+	var resp struct {
+		Tags []Tag `json:"tags"`
+	}
+	if err := b.call(ctx, "list tags", &resp, "getTags"); err != nil {
+		return nil, err
+	}
+
+	return resp.Tags, nil
+}
+
+func (b *socketBackend) getTag(ctx context.Context, id int64) (*Tag, error) {
+	tags, err := b.listTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range tags {
+		if t.ID == id {
+			return &t, nil
+		}
+	}
+
+	return nil, &Error{Op: fmt.Sprintf("get tag %d", id), Err: ErrNotFound}
+}
+
+func (b *socketBackend) createTag(ctx context.Context, t *Tag) (*Tag, error) {
+	var resp struct {
+		Tag Tag `json:"tag"`
+	}
+	tag := map[string]any{"name": t.Name, "color": t.Color, "new": true}
+	if err := b.call(ctx, "create tag", &resp, "addTag", tag); err != nil {
+		return nil, err
+	}
+
+	return &resp.Tag, nil
+}
+
+func (b *socketBackend) updateTag(ctx context.Context, id int64, t *Tag) error {
+	tag := map[string]any{"id": id, "name": t.Name, "color": t.Color}
+
+	return b.call(ctx, fmt.Sprintf("update tag %d", id), nil, "editTag", tag)
+}
+
+func (b *socketBackend) deleteTag(ctx context.Context, id int64) error {
+	b.forget("monitorList")
+
+	return b.call(ctx, fmt.Sprintf("delete tag %d", id), nil, "deleteTag", id)
+}
+
+func (b *socketBackend) addMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	b.forget("monitorList")
+
+	return b.call(ctx, fmt.Sprintf("add tag %d to monitor %d", tagID, monitorID), nil, "addMonitorTag", tagID, monitorID, value)
+}
+
+func (b *socketBackend) deleteMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	b.forget("monitorList")
+
+	return b.call(ctx, fmt.Sprintf("delete tag %d from monitor %d", tagID, monitorID), nil, "deleteMonitorTag", tagID, monitorID, value)
+}
+
+func (b *socketBackend) listUsers(_ context.Context) ([]User, error) {
+	return nil, &Error{Op: "list users", Err: ErrNotSupported}
+}
+
+func (b *socketBackend) getUser(_ context.Context, username string) (*User, error) {
+	return nil, &Error{Op: fmt.Sprintf("get user %q", username), Err: ErrNotSupported}
+}
+
+func (b *socketBackend) listNotifications(ctx context.Context) ([]Notification, error) {
+	raw, err := b.event(ctx, "list notifications", "notificationList")
+	if err != nil {
+		return nil, err
+	}
+
+	var notifications []Notification
+	if err := json.Unmarshal(raw, &notifications); err != nil {
+		return nil, &Error{Op: "list notifications", Err: err}
+	}
+
+	return notifications, nil
+}
+
+func (b *socketBackend) getNotification(ctx context.Context, id int64) (*Notification, error) {
+	notifications, err := b.listNotifications(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, n := range notifications {
+		if n.ID == id {
+			return &n, nil
+		}
+	}
+
+	return nil, &Error{Op: fmt.Sprintf("get notification %d", id), Err: ErrNotFound}
+}
+
+// createNotification saves n through addNotification, which Uptime Kuma also
+// uses for edits: the second argument is null for a new notification or the
+// id of the one to replace.
+func (b *socketBackend) createNotification(ctx context.Context, n *Notification) (int64, error) {
+	b.forget("notificationList")
+	if n.ApplyExisting {
+		b.forget("monitorList")
+	}
+
+	var resp struct {
+		ID int64 `json:"id"`
+	}
+	if err := b.call(ctx, "create notification", &resp, "addNotification", n, nil); err != nil {
+		return 0, err
+	}
+
+	return resp.ID, nil
+}
+
+func (b *socketBackend) updateNotification(ctx context.Context, id int64, n *Notification) error {
+	b.forget("notificationList")
+	if n.ApplyExisting {
+		b.forget("monitorList")
+	}
+
+	return b.call(ctx, fmt.Sprintf("update notification %d", id), nil, "addNotification", n, id)
+}
+
+func (b *socketBackend) deleteNotification(ctx context.Context, id int64) error {
+	b.forget("notificationList")
+	b.forget("monitorList")
+
+	return b.call(ctx, fmt.Sprintf("delete notification %d", id), nil, "deleteNotification", id)
+}
+
+func (b *socketBackend) serverInfo(ctx context.Context) (*ServerInfo, error) {
+	raw, err := b.event(ctx, "get server info", "info")
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		ServerInfo
+		PrimaryBaseURL string `json:"primaryBaseURL"`
+	}
+	if err := json.Unmarshal(raw, &info); err != nil {
+		return nil, &Error{Op: "get server info", Err: err}
+	}
+	if info.ServerInfo.PrimaryBaseUrl == "" {
+		info.ServerInfo.PrimaryBaseUrl = info.PrimaryBaseURL
+	}
+
+	return &info.ServerInfo, nil
+}
+
+// kumaMonitorKeys maps Monitor's JSON keys onto the keys Uptime Kuma uses in
+// its Socket.IO payloads. Keys that are spelled the same are omitted.
+var kumaMonitorKeys = map[string]string{
+	"retry_interval":             "retryInterval",
+	"resend_interval":            "resendInterval",
+	"max_retries":                "maxretries",
+	"upside_down":                "upsideDown",
+	"notification_id_list":       "notificationIDList",
+	"expiry_notification":        "expiryNotification",
+	"ignore_tls":                 "ignoreTls",
+	"max_redirects":              "maxredirects",
+	"proxy_id":                   "proxyId",
+	"auth_method":                "authMethod",
+	"auth_domain":                "authDomain",
+	"auth_workstation":           "authWorkstation",
+	"mqtt_username":              "mqttUsername",
+	"mqtt_password":              "mqttPassword",
+	"mqtt_topic":                 "mqttTopic",
+	"mqtt_success_message":       "mqttSuccessMessage",
+	"database_connection_string": "databaseConnectionString",
+	"database_query":             "databaseQuery",
+	"radius_username":            "radiusUsername",
+	"radius_password":            "radiusPassword",
+	"radius_secret":              "radiusSecret",
+	"radius_called_station_id":   "radiusCalledStationId",
+	"radius_calling_station_id":  "radiusCallingStationId",
+	"force_inactive":             "forceInactive",
+	"gamedig_given_port_only":    "gamedigGivenPortOnly",
+	"grpc_body":                  "grpcBody",
+	"grpc_enable_tls":            "grpcEnableTls",
+	"grpc_metadata":              "grpcMetadata",
+	"grpc_method":                "grpcMethod",
+	"grpc_protobuf":              "grpcProtobuf",
+	"grpc_service_name":          "grpcServiceName",
+	"grpc_url":                   "grpcUrl",
+	"http_body_encoding":         "httpBodyEncoding",
+	"include_sensitive_data":     "includeSensitiveData",
+	"invert_keyword":             "invertKeyword",
+	"json_path":                  "jsonPath",
+	"kafka_producer_allow_auto_topic_creation": "kafkaProducerAllowAutoTopicCreation",
+	"kafka_producer_brokers":                   "kafkaProducerBrokers",
+	"kafka_producer_message":                   "kafkaProducerMessage",
+	"kafka_producer_sasl_options":              "kafkaProducerSaslOptions",
+	"kafka_producer_ssl":                       "kafkaProducerSsl",
+	"kafka_producer_topic":                     "kafkaProducerTopic",
+	"packet_size":                              "packetSize",
+	"path_name":                                "pathName",
+	"push_token":                               "pushToken",
+	"tls_ca":                                   "tlsCa",
+	"tls_cert":                                 "tlsCert",
+	"tls_key":                                  "tlsKey",
+}
+
+// toKumaMonitor converts m into the object Uptime Kuma's add and editMonitor
+// events expect.
+func toKumaMonitor(m *Monitor) (map[string]any, error) {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]any, len(fields))
+	for k, v := range fields {
+		if kk, ok := kumaMonitorKeys[k]; ok {
+			k = kk
+		}
+		out[k] = v
+	}
+
+	// Uptime Kuma keys notifications by id: {"1": true, "4": true}.
+	ids := map[string]bool{}
+	for _, id := range m.NotificationIDList {
+		ids[strconv.FormatInt(id, 10)] = true
+	}
+	out["notificationIDList"] = ids
+
+	if m.KafkaProducerSaslOptions != nil && *m.KafkaProducerSaslOptions != "" {
+		var opts any
+		if json.Unmarshal([]byte(*m.KafkaProducerSaslOptions), &opts) == nil {
+			out["kafkaProducerSaslOptions"] = opts
+		}
+	}
+	if m.OAuthScopes != nil {
+		out["oauth_scopes"] = strings.Join(m.OAuthScopes, " ")
+	}
+
+	if m.AcceptedStatusCodes == nil {
+		out["accepted_statuscodes"] = []string{}
+	}
+	if m.KafkaProducerBrokers == nil {
+		out["kafkaProducerBrokers"] = []string{}
+	}
+
+	return out, nil
+}
+
+// fromKumaMonitor converts an Uptime Kuma monitor object into a Monitor.
+func fromKumaMonitor(km map[string]any) (*Monitor, error) {
+	if km == nil {
+		return nil, errors.New("empty monitor")
+	}
+
+	fromKuma := make(map[string]string, len(kumaMonitorKeys))
+	for k, v := range kumaMonitorKeys {
+		fromKuma[v] = k
+	}
+
+	fields := make(map[string]any, len(km))
+	for k, v := range km {
+		if kk, ok := fromKuma[k]; ok {
+			k = kk
+		}
+		fields[k] = v
+	}
+
+	if v, ok := fields["notification_id_list"]; ok && v != nil {
+		ids, err := notificationIDs(v)
+		if err != nil {
+			return nil, err
+		}
+		fields["notification_id_list"] = ids
+	}
+
+	normalizeMonitorFields(fields)
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+
+	var m Monitor
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+
+	return &m, nil
+}
+
+// notificationIDs decodes a monitor's notification list. Uptime Kuma keys it
+// by id, {"1": true, "4": false}, and only the enabled entries count; the
+// REST bridge may pass it on as a list of ids instead.
+func notificationIDs(v any) ([]int64, error) {
+	var keys []string
+	switch vv := v.(type) {
+	case map[string]any:
+		for id, on := range vv {
+			if truthy(on) {
+				keys = append(keys, id)
+			}
+		}
+	case []any:
+		for _, id := range vv {
+			switch id := id.(type) {
+			case float64:
+				keys = append(keys, strconv.FormatFloat(id, 'f', -1, 64))
+			case string:
+				keys = append(keys, id)
+			default:
+				return nil, fmt.Errorf("notification id %v is not a number", id)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unexpected notification list %v", v)
+	}
+
+	ids := make([]int64, 0, len(keys))
+	for _, key := range keys {
+		id, err := strconv.ParseInt(key, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("notification id %q is not a number", key)
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	return ids, nil
+}
+
+// normalizeMonitorFields coerces values whose JSON type differs between
+// Uptime Kuma and Monitor: SQLite booleans come back as 0/1, parent is a
+// number, SASL options are an object and OAuth scopes a space separated
+// string.
+func normalizeMonitorFields(fields map[string]any) {
+	t := reflect.TypeOf(Monitor{})
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		key, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		v, ok := fields[key]
+		if !ok || v == nil {
+			continue
+		}
+
+		kind := f.Type.Kind()
+		if kind == reflect.Pointer {
+			kind = f.Type.Elem().Kind()
+		}
+
+		switch kind {
+		case reflect.Bool:
+			if n, ok := v.(float64); ok {
+				fields[key] = n != 0
+			}
+		case reflect.String:
+			switch vv := v.(type) {
+			case float64:
+				fields[key] = strconv.FormatFloat(vv, 'f', -1, 64)
+			case map[string]any, []any:
+				data, _ := json.Marshal(vv)
+				fields[key] = string(data)
+			}
+		case reflect.Slice:
+			if s, ok := v.(string); ok && f.Type.Elem().Kind() == reflect.String {
+				fields[key] = strings.Fields(s)
+			}
+		case reflect.Int64:
+			if s, ok := v.(string); ok {
+				n, err := strconv.ParseInt(s, 10, 64)
+				if err != nil {
+					delete(fields, key)
+					continue
+				}
+				fields[key] = n
+			}
+		}
+	}
+}
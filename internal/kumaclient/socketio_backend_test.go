@@ -0,0 +1,79 @@
+package kumaclient
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNotificationIDListRoundTrip(t *testing.T) {
+	km, err := toKumaMonitor(&Monitor{
+		Type:               "http",
+		Name:               "api",
+		NotificationIDList: []int64{4, 1},
+	})
+	if err != nil {
+		t.Fatalf("toKumaMonitor: %v", err)
+	}
+
+	want := map[string]bool{"1": true, "4": true}
+	if got := km["notificationIDList"]; !reflect.DeepEqual(got, want) {
+		t.Errorf("notificationIDList = %#v, want %#v", got, want)
+	}
+
+	// Send it through JSON the way Uptime Kuma answers, with a notification
+	// that has since been switched off.
+	data, _ := json.Marshal(km)
+	var echoed map[string]any
+	if err := json.Unmarshal(data, &echoed); err != nil {
+		t.Fatal(err)
+	}
+	echoed["notificationIDList"].(map[string]any)["7"] = false
+
+	m, err := fromKumaMonitor(echoed)
+	if err != nil {
+		t.Fatalf("fromKumaMonitor: %v", err)
+	}
+	if want := []int64{1, 4}; !reflect.DeepEqual(m.NotificationIDList, want) {
+		t.Errorf("NotificationIDList = %v, want %v", m.NotificationIDList, want)
+	}
+}
+
+func TestNotificationIDs(t *testing.T) {
+	tests := map[string]struct {
+		in      string
+		want    []int64
+		wantErr bool
+	}{
+		"map":             {in: `{"4": true, "1": 1, "7": false, "9": 0}`, want: []int64{1, 4}},
+		"empty map":       {in: `{}`, want: []int64{}},
+		"list of numbers": {in: `[4, 1]`, want: []int64{1, 4}},
+		"list of strings": {in: `["4", "1"]`, want: []int64{1, 4}},
+		"non-numeric key": {in: `{"x": true}`, wantErr: true},
+		"nested list":     {in: `[[1]]`, wantErr: true},
+		"number":          {in: `1`, wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var v any
+			if err := json.Unmarshal([]byte(tt.in), &v); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := notificationIDs(v)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("notificationIDs(%s) = %v, want an error", tt.in, got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("notificationIDs(%s): %v", tt.in, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("notificationIDs(%s) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
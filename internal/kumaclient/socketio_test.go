@@ -0,0 +1,561 @@
+package kumaclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeKuma is an in-process stand-in for Uptime Kuma's Socket.IO endpoint.
+// It speaks Engine.IO v4 over long-polling and answers events through
+// handlers, the same way the server's socket handlers call back.
+type fakeKuma struct {
+	t   *testing.T
+	srv *httptest.Server
+
+	mu       sync.Mutex
+	nextSID  int
+	sessions map[string]*fakeSession
+	handlers map[string]fakeHandler
+	emitted  []string // event names in the order they arrived
+	pongs    int
+}
+
+// fakeHandler answers one event; its result is sent as the acknowledgement.
+type fakeHandler func(s *fakeSession, args []json.RawMessage) any
+
+type fakeSession struct {
+	sid      string
+	out      chan string
+	closed   chan struct{}
+	once     sync.Once
+	loggedIn bool
+	broken   bool // polls fail at the transport level
+}
+
+func (s *fakeSession) push(packet string) {
+	s.out <- packet
+}
+
+// pushEvent sends a server-initiated event to the client.
+func (s *fakeSession) pushEvent(name string, data any) {
+	payload, _ := json.Marshal([]any{name, data})
+	s.push(string(eioMessage) + string(sioEvent) + string(payload))
+}
+
+func (s *fakeSession) close() {
+	s.once.Do(func() { close(s.closed) })
+}
+
+const fakeSessionToken = "session-token"
+
+var fakeMonitors = map[string]any{
+	"1": map[string]any{"id": 1, "name": "api", "type": "http", "url": "https://example.com", "active": true},
+	"2": map[string]any{"id": 2, "name": "db", "type": "port", "hostname": "db.internal", "port": 5432, "active": false},
+}
+
+func newFakeKuma(t *testing.T) *fakeKuma {
+	t.Helper()
+
+	k := &fakeKuma{
+		t:        t,
+		sessions: map[string]*fakeSession{},
+	}
+	k.handlers = map[string]fakeHandler{
+		"login": func(s *fakeSession, args []json.RawMessage) any {
+			var creds struct {
+				Username string `json:"username"`
+				Password string `json:"password"`
+				Token    string `json:"token"`
+			}
+			_ = json.Unmarshal(args[0], &creds)
+			if creds.Username != "admin" || creds.Password != "hunter2" {
+				return map[string]any{"ok": false, "msg": "Incorrect username or password."}
+			}
+			return k.loggedIn(s)
+		},
+		"loginByToken": func(s *fakeSession, args []json.RawMessage) any {
+			var token string
+			_ = json.Unmarshal(args[0], &token)
+			if token != fakeSessionToken {
+				return map[string]any{"ok": false, "msg": "Invalid token."}
+			}
+			return k.loggedIn(s)
+		},
+		"getMonitor": func(s *fakeSession, args []json.RawMessage) any {
+			if !s.loggedIn {
+				return map[string]any{"ok": false, "msg": "You are not logged in."}
+			}
+			var id int64
+			_ = json.Unmarshal(args[0], &id)
+			m, ok := fakeMonitors[fmt.Sprint(id)]
+			if !ok {
+				return map[string]any{"ok": false, "msg": "Cannot read properties of null (reading 'toJSON')"}
+			}
+			return map[string]any{"ok": true, "monitor": m}
+		},
+	}
+
+	k.srv = httptest.NewServer(k)
+	t.Cleanup(func() {
+		k.dropSessions()
+		k.srv.Close()
+	})
+
+	return k
+}
+
+// loggedIn marks s as authenticated and, like Uptime Kuma, follows the
+// login acknowledgement with the lists the UI needs.
+func (k *fakeKuma) loggedIn(s *fakeSession) any {
+	s.loggedIn = true
+	go s.pushEvent("monitorList", fakeMonitors)
+
+	return map[string]any{"ok": true, "token": fakeSessionToken}
+}
+
+// dropSessions forgets every session, as Uptime Kuma does on restart.
+func (k *fakeKuma) dropSessions() {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	for sid, s := range k.sessions {
+		s.close()
+		delete(k.sessions, sid)
+	}
+}
+
+// events returns the names of the events received so far.
+func (k *fakeKuma) events() []string {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return append([]string(nil), k.emitted...)
+}
+
+func (k *fakeKuma) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if r.URL.Path != "/socket.io/" || q.Get("EIO") != "4" || q.Get("transport") != "polling" {
+		http.Error(w, `{"code":0,"message":"Transport unknown"}`, http.StatusBadRequest)
+		return
+	}
+
+	sid := q.Get("sid")
+	if sid == "" {
+		k.open(w)
+		return
+	}
+
+	k.mu.Lock()
+	s, ok := k.sessions[sid]
+	k.mu.Unlock()
+	if !ok {
+		http.Error(w, `{"code":1,"message":"Session ID unknown"}`, http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		k.poll(w, r, s)
+	case http.MethodPost:
+		body, _ := io.ReadAll(r.Body)
+		for _, p := range strings.Split(string(body), eioRecordSeparator) {
+			k.receive(s, p)
+		}
+		_, _ = io.WriteString(w, "ok")
+	}
+}
+
+func (k *fakeKuma) open(w http.ResponseWriter) {
+	k.mu.Lock()
+	k.nextSID++
+	s := &fakeSession{
+		sid:    fmt.Sprintf("sid%d", k.nextSID),
+		out:    make(chan string, 64),
+		closed: make(chan struct{}),
+	}
+	k.sessions[s.sid] = s
+	k.mu.Unlock()
+
+	fmt.Fprintf(w, `%c{"sid":%q,"upgrades":[],"pingInterval":25000,"pingTimeout":20000,"maxPayload":1000000}`, eioOpen, s.sid)
+}
+
+// poll holds the request until there is something to send, then flushes
+// every queued packet in one payload.
+func (k *fakeKuma) poll(w http.ResponseWriter, r *http.Request, s *fakeSession) {
+	k.mu.Lock()
+	broken := s.broken
+	k.mu.Unlock()
+	if broken {
+		// Drop the connection without a response, as when Uptime Kuma
+		// goes away mid-poll.
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			conn.Close()
+		}
+		return
+	}
+
+	var packets []string
+	select {
+	case p := <-s.out:
+		packets = append(packets, p)
+	case <-s.closed:
+		http.Error(w, `{"code":1,"message":"Session ID unknown"}`, http.StatusBadRequest)
+		return
+	case <-r.Context().Done():
+		return
+	}
+
+	for more := true; more; {
+		select {
+		case p := <-s.out:
+			packets = append(packets, p)
+		default:
+			more = false
+		}
+	}
+
+	_, _ = io.WriteString(w, strings.Join(packets, eioRecordSeparator))
+}
+
+func (k *fakeKuma) receive(s *fakeSession, p string) {
+	if p == "" {
+		return
+	}
+
+	switch p[0] {
+	case eioPong:
+		k.mu.Lock()
+		k.pongs++
+		k.mu.Unlock()
+	case eioClose:
+		s.close()
+	case eioMessage:
+		k.message(s, p[1:])
+	}
+}
+
+func (k *fakeKuma) message(s *fakeSession, p string) {
+	switch p[0] {
+	case sioConnect:
+		s.push(string(eioMessage) + string(sioConnect) + `{"sid":"` + s.sid + `-sio"}`)
+		// Exercise the heartbeat right away.
+		s.push(string(eioPing))
+	case sioEvent:
+		id, payload := splitAckID(p[1:])
+
+		var args []json.RawMessage
+		if err := json.Unmarshal([]byte(payload), &args); err != nil || len(args) == 0 {
+			k.t.Errorf("malformed event packet %q", p)
+			return
+		}
+		var name string
+		_ = json.Unmarshal(args[0], &name)
+
+		k.mu.Lock()
+		k.emitted = append(k.emitted, name)
+		h, ok := k.handlers[name]
+		k.mu.Unlock()
+
+		var result any = map[string]any{"ok": false, "msg": "unknown event " + name}
+		if ok {
+			result = h(s, args[1:])
+		}
+		if id < 0 {
+			return
+		}
+
+		ack, _ := json.Marshal([]any{result})
+		s.push(fmt.Sprintf("%c%c%d%s", eioMessage, sioAck, id, ack))
+	}
+}
+
+func newTestSocketClient(t *testing.T, k *fakeKuma, cfg Config) (*Client, error) {
+	t.Helper()
+
+	cfg.Host = k.srv.URL
+	cfg.Backend = BackendSocketIO
+	cfg.RequestTimeout = 5 * time.Second
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c, err := New(ctx, cfg)
+	if err == nil {
+		t.Cleanup(func() { _ = c.Close() })
+	}
+
+	return c, err
+}
+
+func testContext(t *testing.T) context.Context {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	return ctx
+}
+
+func TestSocketHandshake(t *testing.T) {
+	k := newFakeKuma(t)
+
+	conn, err := dialSocket(testContext(t), &http.Client{Timeout: 5 * time.Second}, k.srv.URL)
+	if err != nil {
+		t.Fatalf("dialSocket: %v", err)
+	}
+	defer conn.close()
+
+	if conn.sid != "sid1" {
+		t.Errorf("sid = %q, want %q", conn.sid, "sid1")
+	}
+
+	// The server pings right after connecting; the read loop must answer.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		k.mu.Lock()
+		pongs := k.pongs
+		k.mu.Unlock()
+		if pongs > 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("no pong received for the server's ping")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestSocketHandshakeRejected(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "<html></html>")
+	}))
+	defer srv.Close()
+
+	_, err := dialSocket(testContext(t), srv.Client(), srv.URL)
+	if err == nil || !strings.Contains(err.Error(), "unexpected response") {
+		t.Errorf("dialSocket error = %v, want an unexpected response error", err)
+	}
+}
+
+func TestSocketLogin(t *testing.T) {
+	k := newFakeKuma(t)
+
+	c, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if got := strings.Join(k.events(), ","); got != "login" {
+		t.Errorf("events = %s, want login", got)
+	}
+	if got := c.backend.(*socketBackend).token; got != fakeSessionToken {
+		t.Errorf("kept token %q, want %q", got, fakeSessionToken)
+	}
+}
+
+func TestSocketLoginErrors(t *testing.T) {
+	tests := map[string]struct {
+		ack  map[string]any
+		want error
+	}{
+		"bad credentials": {
+			ack:  map[string]any{"ok": false, "msg": "Incorrect username or password."},
+			want: ErrUnauthorized,
+		},
+		"TOTP required": {
+			ack:  map[string]any{"tokenRequired": true},
+			want: ErrTOTPRequired,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			k := newFakeKuma(t)
+			k.handlers["login"] = func(*fakeSession, []json.RawMessage) any { return tt.ack }
+
+			_, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+			if !errors.Is(err, tt.want) {
+				t.Errorf("New error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestSocketLoginByToken(t *testing.T) {
+	k := newFakeKuma(t)
+
+	if _, err := newTestSocketClient(t, k, Config{Token: fakeSessionToken}); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if got := strings.Join(k.events(), ","); got != "loginByToken" {
+		t.Errorf("events = %s, want loginByToken", got)
+	}
+}
+
+func TestSocketLoginByTokenFallsBackToPassword(t *testing.T) {
+	k := newFakeKuma(t)
+
+	_, err := newTestSocketClient(t, k, Config{Token: "expired", Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	if got := strings.Join(k.events(), ","); got != "loginByToken,login" {
+		t.Errorf("events = %s, want loginByToken,login", got)
+	}
+}
+
+func TestSocketLoginByTokenRejected(t *testing.T) {
+	k := newFakeKuma(t)
+
+	_, err := newTestSocketClient(t, k, Config{Token: "expired"})
+	if !errors.Is(err, ErrUnauthorized) {
+		t.Errorf("New error = %v, want ErrUnauthorized", err)
+	}
+}
+
+func TestSocketAck(t *testing.T) {
+	k := newFakeKuma(t)
+	c, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	m, err := c.GetMonitor(testContext(t), 2)
+	if err != nil {
+		t.Fatalf("GetMonitor: %v", err)
+	}
+	if m.ID != 2 || m.Name != "db" || m.Type != "port" {
+		t.Errorf("GetMonitor = %+v", m)
+	}
+
+	_, err = c.GetMonitor(testContext(t), 99)
+	if !IsNotFound(err) {
+		t.Errorf("GetMonitor(99) error = %v, want not found", err)
+	}
+}
+
+func TestSocketMonitorList(t *testing.T) {
+	k := newFakeKuma(t)
+	c, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	monitors, err := c.ListMonitors(testContext(t))
+	if err != nil {
+		t.Fatalf("ListMonitors: %v", err)
+	}
+	if len(monitors) != 2 {
+		t.Fatalf("ListMonitors returned %d monitors, want 2", len(monitors))
+	}
+	if monitors[0].ID != 1 || monitors[0].Name != "api" || monitors[1].ID != 2 || monitors[1].Name != "db" {
+		t.Errorf("ListMonitors = %+v, want api and db sorted by ID", monitors)
+	}
+
+	// The list is pushed, not requested: no event may have been emitted
+	// beyond the login.
+	if got := strings.Join(k.events(), ","); got != "login" {
+		t.Errorf("events = %s, want login", got)
+	}
+}
+
+func TestSocketReloginAfterSessionLost(t *testing.T) {
+	k := newFakeKuma(t)
+	c, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	// Uptime Kuma restarted and forgot the session.
+	k.dropSessions()
+
+	m, err := c.GetMonitor(testContext(t), 1)
+	if err != nil {
+		t.Fatalf("GetMonitor after the session was lost: %v", err)
+	}
+	if m.Name != "api" {
+		t.Errorf("GetMonitor = %+v", m)
+	}
+
+	// The second session logs in with the token kept from the first.
+	if got := strings.Join(k.events(), ","); !strings.HasSuffix(got, "loginByToken,getMonitor") {
+		t.Errorf("events = %s, want a loginByToken before the retried getMonitor", got)
+	}
+}
+
+func TestSocketReloginWhenNotLoggedIn(t *testing.T) {
+	k := newFakeKuma(t)
+	c, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	// The session is still open but the server no longer considers it
+	// authenticated.
+	k.mu.Lock()
+	for _, s := range k.sessions {
+		s.loggedIn = false
+	}
+	k.mu.Unlock()
+
+	if _, err := c.GetMonitor(testContext(t), 1); err != nil {
+		t.Fatalf("GetMonitor: %v", err)
+	}
+
+	if got := strings.Join(k.events(), ","); got != "login,getMonitor,loginByToken,getMonitor" {
+		t.Errorf("events = %s, want the call retried after logging in again", got)
+	}
+}
+
+// A session whose poll fails at the transport level, rather than with an
+// Engine.IO error, must still be replaced on the next call.
+func TestSocketReloginAfterPollFailed(t *testing.T) {
+	k := newFakeKuma(t)
+	c, err := newTestSocketClient(t, k, Config{Username: "admin", Password: "hunter2"})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	conn, err := c.backend.(*socketBackend).session()
+	if err != nil {
+		t.Fatalf("session: %v", err)
+	}
+
+	k.mu.Lock()
+	for _, s := range k.sessions {
+		s.broken = true
+		s.push(string(eioNoop)) // end the poll in flight
+	}
+	k.mu.Unlock()
+
+	select {
+	case <-conn.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the read loop did not stop after its poll failed")
+	}
+	if err := conn.closedErr(); !errors.Is(err, errSocketClosed) {
+		t.Errorf("closedErr = %v, want it to match errSocketClosed", err)
+	}
+
+	m, err := c.GetMonitor(testContext(t), 1)
+	if err != nil {
+		t.Fatalf("GetMonitor after the poll failed: %v", err)
+	}
+	if m.Name != "api" {
+		t.Errorf("GetMonitor = %+v", m)
+	}
+	if got := strings.Join(k.events(), ","); !strings.HasSuffix(got, "loginByToken,getMonitor") {
+		t.Errorf("events = %s, want a loginByToken before the retried getMonitor", got)
+	}
+}
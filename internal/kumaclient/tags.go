@@ -0,0 +1,70 @@
+package kumaclient
+
+import (
+	"context"
+)
+
+// Tag is a tag definition that can be attached to monitors.
+type Tag struct {
+	ID    int64  `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+// ListTags returns every tag.
+func (c *Client) ListTags(ctx context.Context) ([]Tag, error) {
+	return withAuth(ctx, c, func() ([]Tag, error) {
+		return c.backend.listTags(ctx)
+	})
+}
+
+// GetTag returns the tag with the given id.
+func (c *Client) GetTag(ctx context.Context, id int64) (*Tag, error) {
+	return withAuth(ctx, c, func() (*Tag, error) {
+		return c.backend.getTag(ctx, id)
+	})
+}
+
+// CreateTag creates t and returns the tag as stored by the server.
+func (c *Client) CreateTag(ctx context.Context, t *Tag) (*Tag, error) {
+	return withAuth(ctx, c, func() (*Tag, error) {
+		return c.backend.createTag(ctx, t)
+	})
+}
+
+// UpdateTag replaces the name and color of the tag with the given id and
+// returns the tag as stored by the server.
+func (c *Client) UpdateTag(ctx context.Context, id int64, t *Tag) (*Tag, error) {
+	err := doWithAuth(ctx, c, func() error {
+		return c.backend.updateTag(ctx, id, t)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.GetTag(ctx, id)
+}
+
+// DeleteTag deletes the tag with the given id, detaching it from every
+// monitor.
+func (c *Client) DeleteTag(ctx context.Context, id int64) error {
+	return doWithAuth(ctx, c, func() error {
+		return c.backend.deleteTag(ctx, id)
+	})
+}
+
+// AddMonitorTag attaches the tag with the given id to a monitor. The same tag
+// can be attached several times with different values.
+func (c *Client) AddMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	return doWithAuth(ctx, c, func() error {
+		return c.backend.addMonitorTag(ctx, tagID, monitorID, value)
+	})
+}
+
+// DeleteMonitorTag detaches the tag with the given id and value from a
+// monitor.
+func (c *Client) DeleteMonitorTag(ctx context.Context, tagID, monitorID int64, value string) error {
+	return doWithAuth(ctx, c, func() error {
+		return c.backend.deleteMonitorTag(ctx, tagID, monitorID, value)
+	})
+}
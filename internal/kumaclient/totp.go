@@ -0,0 +1,80 @@
+package kumaclient
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Uptime Kuma's two-factor authentication uses standard RFC 6238 TOTP codes:
+// HMAC-SHA1 over 30 second steps, truncated to six digits.
+const (
+	totpPeriod = 30 * time.Second
+	totpDigits = 6
+)
+
+// decodeTOTPSecret decodes a base32 secret as shown by authenticator apps,
+// tolerating lower case, spaces and missing padding.
+func decodeTOTPSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.Join(strings.Fields(secret), ""))
+	secret = strings.TrimRight(secret, "=")
+
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP secret: %w", err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("invalid TOTP secret: empty")
+	}
+
+	return key, nil
+}
+
+// currentTOTP returns the code for secret at the current time, or "" when no
+// secret is configured.
+func currentTOTP(secret string) (string, error) {
+	if secret == "" {
+		return "", nil
+	}
+
+	key, err := decodeTOTPSecret(secret)
+	if err != nil {
+		return "", err
+	}
+
+	return totpCode(key, time.Now()), nil
+}
+
+// isTOTPRequiredMessage reports whether a login error message asks for a
+// two-factor code; the REST bridge passes Uptime Kuma's message through.
+func isTOTPRequiredMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+
+	return strings.Contains(msg, "tokenrequired") ||
+		strings.Contains(msg, "token required") ||
+		strings.Contains(msg, "2fa")
+}
+
+// totpCode returns the TOTP code for key at time t.
+func totpCode(key []byte, t time.Time) string {
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(t.Unix()/int64(totpPeriod/time.Second)))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	code := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+
+	return fmt.Sprintf("%0*d", totpDigits, code%mod)
+}
@@ -0,0 +1,242 @@
+package kumaclient
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"log"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// loginHandler answers the REST bridge login with a token and 404s
+// everything else.
+var loginHandler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/login/access-token" {
+		http.NotFound(w, r)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = io.WriteString(w, `{"access_token":"abc","token_type":"bearer"}`)
+})
+
+// tlsLoginServer starts a REST bridge stand-in over TLS, letting configure
+// adjust the server's TLS settings before it starts.
+func tlsLoginServer(t *testing.T, configure func(*tls.Config)) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewUnstartedServer(loginHandler)
+	srv.Config.ErrorLog = log.New(io.Discard, "", 0) // failed handshakes are expected
+	srv.TLS = &tls.Config{MinVersion: tls.VersionTLS12}
+	if configure != nil {
+		configure(srv.TLS)
+	}
+	srv.StartTLS()
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+// certPEM PEM-encodes a DER certificate.
+func certPEM(der []byte) string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+}
+
+// newClientCertificate generates a self-signed client certificate and
+// returns it parsed and as PEM, along with its PEM-encoded key.
+func newClientCertificate(t *testing.T) (cert *x509.Certificate, certPEMBlock, keyPEMBlock string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generating key: %v", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("creating certificate: %v", err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("parsing certificate: %v", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("marshalling key: %v", err)
+	}
+
+	return cert, certPEM(der), string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}))
+}
+
+func newTestTLSClient(host string, cfg TLSConfig) (*Client, error) {
+	return New(context.Background(), Config{
+		Host:     host,
+		Username: "admin",
+		Password: "hunter2",
+		TLS:      cfg,
+	})
+}
+
+func TestTLSServerCertificate(t *testing.T) {
+	srv := tlsLoginServer(t, nil)
+	// httptest servers all share one certificate, so an unrelated CA has to
+	// be generated.
+	_, otherCA, _ := newClientCertificate(t)
+
+	tests := map[string]struct {
+		cfg     TLSConfig
+		wantTLS bool
+	}{
+		"system roots only": {
+			wantTLS: true,
+		},
+		"CA bundle": {
+			cfg: TLSConfig{CACertPEM: certPEM(srv.Certificate().Raw)},
+		},
+		"unrelated CA bundle": {
+			cfg:     TLSConfig{CACertPEM: otherCA},
+			wantTLS: true,
+		},
+		"insecure_skip_verify": {
+			cfg: TLSConfig{InsecureSkipVerify: true},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newTestTLSClient(srv.URL, tt.cfg)
+			switch {
+			case tt.wantTLS && !IsTLSError(err):
+				t.Fatalf("New returned %v, want a TLS error", err)
+			case !tt.wantTLS && err != nil:
+				t.Fatalf("New: %v", err)
+			}
+		})
+	}
+}
+
+func TestTLSClientCertificate(t *testing.T) {
+	cert, certPEMBlock, keyPEMBlock := newClientCertificate(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(cert)
+
+	var (
+		mu      sync.Mutex
+		subject string
+	)
+	srv := tlsLoginServer(t, func(c *tls.Config) {
+		c.ClientAuth = tls.RequireAndVerifyClientCert
+		c.ClientCAs = clientCAs
+		c.VerifyConnection = func(cs tls.ConnectionState) error {
+			if len(cs.PeerCertificates) > 0 {
+				mu.Lock()
+				subject = cs.PeerCertificates[0].Subject.CommonName
+				mu.Unlock()
+			}
+			return nil
+		}
+	})
+	caPEM := certPEM(srv.Certificate().Raw)
+
+	if _, err := newTestTLSClient(srv.URL, TLSConfig{CACertPEM: caPEM}); err == nil {
+		t.Fatal("New succeeded without a client certificate")
+	}
+
+	_, err := newTestTLSClient(srv.URL, TLSConfig{
+		CACertPEM:     caPEM,
+		ClientCertPEM: certPEMBlock,
+		ClientKeyPEM:  keyPEMBlock,
+	})
+	if err != nil {
+		t.Fatalf("New: %v", err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	if subject != "terraform" {
+		t.Errorf("server saw client certificate %q, want %q", subject, "terraform")
+	}
+}
+
+func TestProxyURL(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		hosts []string
+	)
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		hosts = append(hosts, r.URL.Host)
+		mu.Unlock()
+		loginHandler(w, r)
+	}))
+	t.Cleanup(proxy.Close)
+
+	if _, err := newTestTLSClient("http://kuma.invalid", TLSConfig{ProxyURL: proxy.URL}); err != nil {
+		t.Fatalf("New: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(hosts) != 1 || hosts[0] != "kuma.invalid" {
+		t.Errorf("proxy saw requests for %q, want one for %q", hosts, "kuma.invalid")
+	}
+}
+
+func TestNewHTTPTransportErrors(t *testing.T) {
+	_, certPEMBlock, keyPEMBlock := newClientCertificate(t)
+
+	tests := map[string]struct {
+		cfg  TLSConfig
+		want string
+	}{
+		"CA bundle without certificates": {
+			cfg:  TLSConfig{CACertPEM: "not a certificate"},
+			want: "no certificates found in CA bundle",
+		},
+		"client certificate without key": {
+			cfg:  TLSConfig{ClientCertPEM: certPEMBlock},
+			want: "must be set together",
+		},
+		"client key without certificate": {
+			cfg:  TLSConfig{ClientKeyPEM: keyPEMBlock},
+			want: "must be set together",
+		},
+		"mismatched client certificate and key": {
+			cfg:  TLSConfig{ClientCertPEM: certPEMBlock, ClientKeyPEM: certPEMBlock},
+			want: "loading client certificate",
+		},
+		"proxy without scheme": {
+			cfg:  TLSConfig{ProxyURL: "proxy.example.com:3128"},
+			want: "must include a scheme and host",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := newTestTLSClient("https://kuma.invalid", tt.cfg)
+			if !errors.Is(err, ErrInvalidConfig) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("New returned %v, want an invalid config error containing %q", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package kumaclient
+
+import (
+	"context"
+)
+
+// User is an account known to the API.
+type User struct {
+	ID        int64  `json:"id"`
+	Username  string `json:"username"`
+	CreatedAt string `json:"created_at"`
+	LastVisit string `json:"last_visit"`
+}
+
+// ListUsers returns every user.
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	return withAuth(ctx, c, func() ([]User, error) {
+		return c.backend.listUsers(ctx)
+	})
+}
+
+// GetUser returns the user with the given username.
+func (c *Client) GetUser(ctx context.Context, username string) (*User, error) {
+	return withAuth(ctx, c, func() (*User, error) {
+		return c.backend.getUser(ctx, username)
+	})
+}
@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = hexColorValidator{}
+
+// hexColor matches the #RGB and #RRGGBB colors Uptime Kuma stores for tags.
+var hexColor = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// hexColorValidator checks that a string is a hex color such as "#2563EB".
+type hexColorValidator struct{}
+
+func (v hexColorValidator) Description(_ context.Context) string {
+	return `Must be a hex color such as "#2563EB".`
+}
+
+func (v hexColorValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v hexColorValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if !hexColor.MatchString(req.ConfigValue.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Color",
+			fmt.Sprintf("%q is not a valid color. %s", req.ConfigValue.ValueString(), v.Description(ctx)),
+		)
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestHexColorValidator(t *testing.T) {
+	tests := map[string]struct {
+		value types.String
+		valid bool
+	}{
+		"short":      {value: types.StringValue("#abc"), valid: true},
+		"long":       {value: types.StringValue("#AABBCC"), valid: true},
+		"mixed case": {value: types.StringValue("#2563eB"), valid: true},
+		"null":       {value: types.StringNull(), valid: true},
+		"unknown":    {value: types.StringUnknown(), valid: true},
+
+		"missing hash":   {value: types.StringValue("abc")},
+		"non-hex digits": {value: types.StringValue("#ggg")},
+		"empty":          {value: types.StringValue("")},
+		"four digits":    {value: types.StringValue("#abcd")},
+		"named color":    {value: types.StringValue("blue")},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("color"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.StringResponse
+			hexColorValidator{}.ValidateString(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() == tt.valid {
+				t.Errorf("diagnostics = %v, want valid %t", resp.Diagnostics, tt.valid)
+			}
+		})
+	}
+}
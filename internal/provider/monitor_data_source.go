@@ -2,14 +2,14 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	"github.com/carlmjohnson/requests"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -24,8 +24,7 @@ func NewMonitorDataSource() datasource.DataSource {
 }
 
 type data_monitorAuth struct {
-	Host  string
-	Token string
+	client *kumaclient.Client
 }
 
 type tagInstanceDataModel struct {
@@ -35,179 +34,86 @@ type tagInstanceDataModel struct {
 	Value types.String `tfsdk:"value"`
 }
 
-type JSON_tagInstanceDataModel struct {
-	ID    int64  `json:"id"`
-	Name  string `json:"name"`
-	Color string `json:"color"`
-	Value string `json:"value"`
-}
-
 type monitorModel struct {
-	ID                                  types.Int64            `tfsdk:"id"`
-	Type                                types.String           `tfsdk:"type"`
-	Name                                types.String           `tfsdk:"name"`
-	Interval                            types.Int64            `tfsdk:"interval"`
-	RetryInterval                       types.Int64            `tfsdk:"retry_interval"`
-	ResendInterval                      types.Int64            `tfsdk:"resend_interval"`
-	MaxRetries                          types.Int64            `tfsdk:"max_retries"`
-	UpsideDown                          types.Bool             `tfsdk:"upside_down"`
-	NotificationIDList                  types.Set              `tfsdk:"notification_id_list"`
-	URL                                 types.String           `tfsdk:"url"`
-	ExpiryNotification                  types.Bool             `tfsdk:"expiry_notification"`
-	IgnoreTls                           types.Bool             `tfsdk:"ignore_tls"`
-	MaxRedirects                        types.Int64            `tfsdk:"max_redirects"`
-	AcceptedStatusCodes                 types.Set              `tfsdk:"accepted_statuscodes"`
-	ProxyID                             types.Int64            `tfsdk:"proxy_id"`
-	Method                              types.String           `tfsdk:"method"`
-	Body                                types.String           `tfsdk:"body"`
-	Headers                             types.String           `tfsdk:"headers"`
-	AuthMethod                          types.String           `tfsdk:"auth_method"`
-	BasicAuthUser                       types.String           `tfsdk:"basic_auth_user"`
-	BasicAuthPass                       types.String           `tfsdk:"basic_auth_pass"`
-	AuthDomain                          types.String           `tfsdk:"auth_domain"`
-	AuthWorkstation                     types.String           `tfsdk:"auth_workstation"`
-	Keyword                             types.String           `tfsdk:"keyword"`
-	Hostname                            types.String           `tfsdk:"hostname"`
-	Port                                types.Int64            `tfsdk:"port"`
-	DNSResolveServer                    types.String           `tfsdk:"dns_resolve_server"`
-	DNSResolveType                      types.String           `tfsdk:"dns_resolve_type"`
-	MQTTUsername                        types.String           `tfsdk:"mqtt_username"`
-	MQTTPassword                        types.String           `tfsdk:"mqtt_password"`
-	MQTTTopic                           types.String           `tfsdk:"mqtt_topic"`
-	MQTTSucessMessage                   types.String           `tfsdk:"mqtt_success_message"`
-	DatabaseConnectionString            types.String           `tfsdk:"database_connection_string"`
-	DatabaseQuery                       types.String           `tfsdk:"database_query"`
-	DockerContainer                     types.String           `tfsdk:"docker_container"`
-	DockerHost                          types.Int64            `tfsdk:"docker_host"`
-	RadiusUsername                      types.String           `tfsdk:"radius_username"`
-	RadiusPassword                      types.String           `tfsdk:"radius_password"`
-	RadiusSecret                        types.String           `tfsdk:"radius_secret"`
-	RadiusCalledStationId               types.String           `tfsdk:"radius_called_station_id"`
-	RadiusCallingStationId              types.String           `tfsdk:"radius_calling_station_id"`
-	Active                              types.Bool             `tfsdk:"active"`
-	ForceInactive                       types.Bool             `tfsdk:"force_inactive"`
-	Game                                types.String           `tfsdk:"game"`
-	GamedigGivenPortOnly                types.Bool             `tfsdk:"gamedig_given_port_only"`
-	GrpcBody                            types.String           `tfsdk:"grpc_body"`
-	GrpcEnableTls                       types.Bool             `tfsdk:"grpc_enable_tls"`
-	GrpcMetadata                        types.String           `tfsdk:"grpc_metadata"`
-	GrpcMethod                          types.String           `tfsdk:"grpc_method"`
-	GrpcProtobuf                        types.String           `tfsdk:"grpc_protobuf"`
-	GrpcServiceName                     types.String           `tfsdk:"grpc_service_name"`
-	GrpcUrl                             types.String           `tfsdk:"grpc_url"`
-	HttpBodyEncoding                    types.String           `tfsdk:"http_body_encoding"`
-	IncludeSensitiveData                types.Bool             `tfsdk:"include_sensitive_data"`
-	InvertKeyword                       types.Bool             `tfsdk:"invert_keyword"`
-	JsonPath                            types.String           `tfsdk:"json_path"`
-	KafkaProducerAllowAutoTopicCreation types.Bool             `tfsdk:"kafka_producer_allow_auto_topic_creation"`
-	KafkaProducerBrokers                types.Set              `tfsdk:"kafka_producer_brokers"`
-	KafkaProducerMessage                types.String           `tfsdk:"kafka_producer_message"`
-	KafkaProducerSaslOptions            types.String           `tfsdk:"kafka_producer_sasl_options"`
-	KafkaProducerSsl                    types.Bool             `tfsdk:"kafka_producer_ssl"`
-	KafkaProducerTopic                  types.String           `tfsdk:"kafka_producer_topic"`
-	Maintenance                         types.Bool             `tfsdk:"maintenance"`
-	OAuthAuthMethod                     types.String           `tfsdk:"oauth_auth_method"`
-	OAuthClientID                       types.String           `tfsdk:"oauth_client_id"`
-	OAuthClientSecret                   types.String           `tfsdk:"oauth_client_secret"`
-	OAuthScopes                         types.Set              `tfsdk:"oauth_scopes"`
-	OAuthTokenURL                       types.String           `tfsdk:"oauth_token_url"`
-	PacketSize                          types.Int64            `tfsdk:"packet_size"`
-	Parent                              types.String           `tfsdk:"parent"`
-	PathName                            types.String           `tfsdk:"path_name"`
-	PushToken                           types.String           `tfsdk:"push_token"`
-	Screenshot                          types.String           `tfsdk:"screenshot"`
-	Tags                                []tagInstanceDataModel `tfsdk:"tags"`
-	Timeout                             types.Int64            `tfsdk:"timeout"`
-	TlsCa                               types.String           `tfsdk:"tls_ca"`
-	TlsCert                             types.String           `tfsdk:"tls_cert"`
-	TlsKey                              types.String           `tfsdk:"tls_key"`
-	Weight                              types.Int64            `tfsdk:"weight"`
-}
-
-type JSON_monitorModel struct {
-	ID                                  int64                       `json:"id"`
-	Type                                string                      `json:"type"`
-	Name                                string                      `json:"name"`
-	Interval                            int64                       `json:"interval"`
-	RetryInterval                       int64                       `json:"retry_interval"`
-	ResendInterval                      int64                       `json:"resend_interval"`
-	MaxRetries                          int64                       `json:"max_retries"`
-	UpsideDown                          bool                        `json:"upside_down"`
-	NotificationIDList                  []string                    `json:"notification_id_list"`
-	URL                                 string                      `json:"url"`
-	ExpiryNotification                  bool                        `json:"expiry_notification"`
-	IgnoreTls                           bool                        `json:"ignore_tls"`
-	MaxRedirects                        int64                       `json:"max_redirects"`
-	AcceptedStatusCodes                 []string                    `json:"accepted_statuscodes"`
-	ProxyID                             int64                       `json:"proxy_id"`
-	Method                              string                      `json:"method"`
-	Body                                string                      `json:"body"`
-	Headers                             string                      `json:"headers"`
-	AuthMethod                          string                      `json:"auth_method"`
-	BasicAuthUser                       string                      `json:"basic_auth_user"`
-	BasicAuthPass                       string                      `json:"basic_auth_pass"`
-	AuthDomain                          string                      `json:"auth_domain"`
-	AuthWorkstation                     string                      `json:"auth_workstation"`
-	Keyword                             string                      `json:"keyword"`
-	Hostname                            string                      `json:"hostname"`
-	Port                                int64                       `json:"port"`
-	DNSResolveServer                    string                      `json:"dns_resolve_server"`
-	DNSResolveType                      string                      `json:"dns_resolve_type"`
-	MQTTUsername                        string                      `json:"mqtt_username"`
-	MQTTPassword                        string                      `json:"mqtt_password"`
-	MQTTTopic                           string                      `json:"mqtt_topic"`
-	MQTTSucessMessage                   string                      `json:"mqtt_success_message"`
-	DatabaseConnectionString            string                      `json:"database_connection_string"`
-	DatabaseQuery                       string                      `json:"database_query"`
-	DockerContainer                     string                      `json:"docker_container"`
-	DockerHost                          int64                       `json:"docker_host"`
-	RadiusUsername                      string                      `json:"radius_username"`
-	RadiusPassword                      string                      `json:"radius_password"`
-	RadiusSecret                        string                      `json:"radius_secret"`
-	RadiusCalledStationId               string                      `json:"radius_called_station_id"`
-	RadiusCallingStationId              string                      `json:"radius_calling_station_id"`
-	Active                              bool                        `json:"active"`
-	ForceInactive                       bool                        `json:"force_inactive"`
-	Game                                string                      `json:"game"`
-	GamedigGivenPortOnly                bool                        `json:"gamedig_given_port_only"`
-	GrpcBody                            string                      `json:"grpc_body"`
-	GrpcEnableTls                       bool                        `json:"grpc_enable_tls"`
-	GrpcMetadata                        string                      `json:"grpc_metadata"`
-	GrpcMethod                          string                      `json:"grpc_method"`
-	GrpcProtobuf                        string                      `json:"grpc_protobuf"`
-	GrpcServiceName                     string                      `json:"grpc_service_name"`
-	GrpcUrl                             string                      `json:"grpc_url"`
-	HttpBodyEncoding                    string                      `json:"http_body_encoding"`
-	IncludeSensitiveData                bool                        `json:"include_sensitive_data"`
-	InvertKeyword                       bool                        `json:"invert_keyword"`
-	JsonPath                            string                      `json:"json_path"`
-	KafkaProducerAllowAutoTopicCreation bool                        `json:"kafka_producer_allow_auto_topic_creation"`
-	KafkaProducerBrokers                []string                    `json:"kafka_producer_brokers"`
-	KafkaProducerMessage                string                      `json:"kafka_producer_message"`
-	KafkaProducerSaslOptions            string                      `json:"kafka_producer_sasl_options"`
-	KafkaProducerSsl                    bool                        `json:"kafka_producer_ssl"`
-	KafkaProducerTopic                  string                      `json:"kafka_producer_topic"`
-	Maintenance                         bool                        `json:"maintenance"`
-	OAuthAuthMethod                     string                      `json:"oauth_auth_method"`
-	OAuthClientID                       string                      `json:"oauth_client_id"`
-	OAuthClientSecret                   string                      `json:"oauth_client_secret"`
-	OAuthScopes                         []string                    `json:"oauth_scopes"`
-	OAuthTokenURL                       string                      `json:"oauth_token_url"`
-	PacketSize                          int64                       `json:"packet_size"`
-	Parent                              string                      `json:"parent"`
-	PathName                            string                      `json:"path_name"`
-	PushToken                           string                      `json:"push_token"`
-	Screenshot                          string                      `json:"screenshot"`
-	Tags                                []JSON_tagInstanceDataModel `json:"tags"`
-	Timeout                             int64                       `json:"timeout"`
-	TlsCa                               string                      `json:"tls_ca"`
-	TlsCert                             string                      `json:"tls_cert"`
-	TlsKey                              string                      `json:"tls_key"`
-	Weight                              int64                       `json:"weight"`
-}
-
-type monitorResponse struct {
-	Monitor JSON_monitorModel `json:"monitor"`
+	ID                                  types.Int64            `tfsdk:"id" kuma:"id"`
+	Type                                types.String           `tfsdk:"type" kuma:"type"`
+	Name                                types.String           `tfsdk:"name" kuma:"name"`
+	Interval                            types.Int64            `tfsdk:"interval" kuma:"interval"`
+	RetryInterval                       types.Int64            `tfsdk:"retry_interval" kuma:"retry_interval"`
+	ResendInterval                      types.Int64            `tfsdk:"resend_interval" kuma:"resend_interval"`
+	MaxRetries                          types.Int64            `tfsdk:"max_retries" kuma:"max_retries"`
+	UpsideDown                          types.Bool             `tfsdk:"upside_down" kuma:"upside_down"`
+	NotificationIDList                  types.Set              `tfsdk:"notification_id_list" kuma:"notification_id_list,int64"`
+	URL                                 types.String           `tfsdk:"url" kuma:"url"`
+	ExpiryNotification                  types.Bool             `tfsdk:"expiry_notification" kuma:"expiry_notification"`
+	IgnoreTls                           types.Bool             `tfsdk:"ignore_tls" kuma:"ignore_tls"`
+	MaxRedirects                        types.Int64            `tfsdk:"max_redirects" kuma:"max_redirects"`
+	AcceptedStatusCodes                 types.Set              `tfsdk:"accepted_statuscodes" kuma:"accepted_statuscodes"`
+	ProxyID                             types.Int64            `tfsdk:"proxy_id" kuma:"proxy_id"`
+	Method                              types.String           `tfsdk:"method" kuma:"method"`
+	Body                                types.String           `tfsdk:"body" kuma:"body"`
+	Headers                             types.String           `tfsdk:"headers" kuma:"headers"`
+	AuthMethod                          types.String           `tfsdk:"auth_method" kuma:"auth_method"`
+	BasicAuthUser                       types.String           `tfsdk:"basic_auth_user" kuma:"basic_auth_user"`
+	BasicAuthPass                       types.String           `tfsdk:"basic_auth_pass" kuma:"basic_auth_pass"`
+	AuthDomain                          types.String           `tfsdk:"auth_domain" kuma:"auth_domain"`
+	AuthWorkstation                     types.String           `tfsdk:"auth_workstation" kuma:"auth_workstation"`
+	Keyword                             types.String           `tfsdk:"keyword" kuma:"keyword"`
+	Hostname                            types.String           `tfsdk:"hostname" kuma:"hostname"`
+	Port                                types.Int64            `tfsdk:"port" kuma:"port"`
+	DNSResolveServer                    types.String           `tfsdk:"dns_resolve_server" kuma:"dns_resolve_server"`
+	DNSResolveType                      types.String           `tfsdk:"dns_resolve_type" kuma:"dns_resolve_type"`
+	MQTTUsername                        types.String           `tfsdk:"mqtt_username" kuma:"mqtt_username"`
+	MQTTPassword                        types.String           `tfsdk:"mqtt_password" kuma:"mqtt_password"`
+	MQTTTopic                           types.String           `tfsdk:"mqtt_topic" kuma:"mqtt_topic"`
+	MQTTSucessMessage                   types.String           `tfsdk:"mqtt_success_message" kuma:"mqtt_success_message"`
+	DatabaseConnectionString            types.String           `tfsdk:"database_connection_string" kuma:"database_connection_string"`
+	DatabaseQuery                       types.String           `tfsdk:"database_query" kuma:"database_query"`
+	DockerContainer                     types.String           `tfsdk:"docker_container" kuma:"docker_container"`
+	DockerHost                          types.Int64            `tfsdk:"docker_host" kuma:"docker_host"`
+	RadiusUsername                      types.String           `tfsdk:"radius_username" kuma:"radius_username"`
+	RadiusPassword                      types.String           `tfsdk:"radius_password" kuma:"radius_password"`
+	RadiusSecret                        types.String           `tfsdk:"radius_secret" kuma:"radius_secret"`
+	RadiusCalledStationId               types.String           `tfsdk:"radius_called_station_id" kuma:"radius_called_station_id"`
+	RadiusCallingStationId              types.String           `tfsdk:"radius_calling_station_id" kuma:"radius_calling_station_id"`
+	Active                              types.Bool             `tfsdk:"active" kuma:"active"`
+	ForceInactive                       types.Bool             `tfsdk:"force_inactive" kuma:"force_inactive"`
+	Game                                types.String           `tfsdk:"game" kuma:"game"`
+	GamedigGivenPortOnly                types.Bool             `tfsdk:"gamedig_given_port_only" kuma:"gamedig_given_port_only"`
+	GrpcBody                            types.String           `tfsdk:"grpc_body" kuma:"grpc_body"`
+	GrpcEnableTls                       types.Bool             `tfsdk:"grpc_enable_tls" kuma:"grpc_enable_tls"`
+	GrpcMetadata                        types.String           `tfsdk:"grpc_metadata" kuma:"grpc_metadata"`
+	GrpcMethod                          types.String           `tfsdk:"grpc_method" kuma:"grpc_method"`
+	GrpcProtobuf                        types.String           `tfsdk:"grpc_protobuf" kuma:"grpc_protobuf"`
+	GrpcServiceName                     types.String           `tfsdk:"grpc_service_name" kuma:"grpc_service_name"`
+	GrpcUrl                             types.String           `tfsdk:"grpc_url" kuma:"grpc_url"`
+	HttpBodyEncoding                    types.String           `tfsdk:"http_body_encoding" kuma:"http_body_encoding"`
+	IncludeSensitiveData                types.Bool             `tfsdk:"include_sensitive_data" kuma:"include_sensitive_data"`
+	InvertKeyword                       types.Bool             `tfsdk:"invert_keyword" kuma:"invert_keyword"`
+	JsonPath                            types.String           `tfsdk:"json_path" kuma:"json_path"`
+	KafkaProducerAllowAutoTopicCreation types.Bool             `tfsdk:"kafka_producer_allow_auto_topic_creation" kuma:"kafka_producer_allow_auto_topic_creation"`
+	KafkaProducerBrokers                types.Set              `tfsdk:"kafka_producer_brokers" kuma:"kafka_producer_brokers"`
+	KafkaProducerMessage                types.String           `tfsdk:"kafka_producer_message" kuma:"kafka_producer_message"`
+	KafkaProducerSaslOptions            types.String           `tfsdk:"kafka_producer_sasl_options" kuma:"kafka_producer_sasl_options"`
+	KafkaProducerSsl                    types.Bool             `tfsdk:"kafka_producer_ssl" kuma:"kafka_producer_ssl"`
+	KafkaProducerTopic                  types.String           `tfsdk:"kafka_producer_topic" kuma:"kafka_producer_topic"`
+	Maintenance                         types.Bool             `tfsdk:"maintenance" kuma:"maintenance"`
+	OAuthAuthMethod                     types.String           `tfsdk:"oauth_auth_method" kuma:"oauth_auth_method"`
+	OAuthClientID                       types.String           `tfsdk:"oauth_client_id" kuma:"oauth_client_id"`
+	OAuthClientSecret                   types.String           `tfsdk:"oauth_client_secret" kuma:"oauth_client_secret"`
+	OAuthScopes                         types.Set              `tfsdk:"oauth_scopes" kuma:"oauth_scopes"`
+	OAuthTokenURL                       types.String           `tfsdk:"oauth_token_url" kuma:"oauth_token_url"`
+	PacketSize                          types.Int64            `tfsdk:"packet_size" kuma:"packet_size"`
+	Parent                              types.String           `tfsdk:"parent" kuma:"parent"`
+	PathName                            types.String           `tfsdk:"path_name" kuma:"path_name"`
+	PushToken                           types.String           `tfsdk:"push_token" kuma:"push_token"`
+	Screenshot                          types.String           `tfsdk:"screenshot" kuma:"screenshot"`
+	Tags                                []tagInstanceDataModel `tfsdk:"tags" kuma:"-"`
+	Timeout                             types.Int64            `tfsdk:"timeout" kuma:"timeout"`
+	TlsCa                               types.String           `tfsdk:"tls_ca" kuma:"tls_ca"`
+	TlsCert                             types.String           `tfsdk:"tls_cert" kuma:"tls_cert"`
+	TlsKey                              types.String           `tfsdk:"tls_key" kuma:"tls_key"`
+	Weight                              types.Int64            `tfsdk:"weight" kuma:"weight"`
 }
 
 // Configure adds the provider configured client to the data source.
@@ -218,16 +124,16 @@ func (d *data_monitorAuth) Configure(ctx context.Context, req datasource.Configu
 		return
 	}
 
-	authbytes, ok := req.ProviderData.([]byte)
+	client, ok := req.ProviderData.(*kumaclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected []byte, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	json.Unmarshal(authbytes, &d)
+	d.client = client
 	tflog.Debug(ctx, "CONFIG received good token")
 
 }
@@ -250,7 +156,7 @@ func (d *data_monitorAuth) Schema(_ context.Context, _ datasource.SchemaRequest,
 			"max_retries":     schema.Int64Attribute{Computed: true},
 			"upside_down":     schema.BoolAttribute{Computed: true},
 			"notification_id_list": schema.SetAttribute{
-				ElementType: types.NumberType,
+				ElementType: types.Int64Type,
 				Computed:    true,
 			},
 			"url":                 schema.StringAttribute{Computed: true},
@@ -377,6 +283,7 @@ func (d *data_monitorAuth) Schema(_ context.Context, _ datasource.SchemaRequest,
 
 // Read refreshes the Terraform state with the latest data.
 func (d *data_monitorAuth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withRedaction(ctx)
 
 	var state monitorModel
 
@@ -386,137 +293,23 @@ func (d *data_monitorAuth) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	id := cleanString(state.ID.String())
-
-	var respDebug string
-	tflog.Debug(ctx, "Requesting "+d.Host+"/monitors/"+id)
-	err := requests.
-		URL(d.Host).
-		Bearer(d.Token).
-		Path("/monitors/" + id).
-		ToString(&respDebug).
-		Fetch(ctx)
+	tflog.Debug(ctx, fmt.Sprintf("Requesting monitor %d", state.ID.ValueInt64()))
+	monitor, err := d.client.GetMonitor(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error with response",
-			"got "+err.Error()+"\n"+
-				"token was "+d.Token,
-		)
-	}
-
-	var response monitorResponse
-	err = json.Unmarshal([]byte(respDebug), &response)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error unmarshalling response",
-			err.Error(),
+			"got "+err.Error(),
 		)
+		return
 	}
 
-	tflog.Debug(ctx, "Request got "+respDebug)
-
-	notificationIDs, diags := types.SetValueFrom(ctx, types.NumberType, response.Monitor.NotificationIDList)
-	resp.Diagnostics.Append(diags...)
-	acceptedStatusCodes, diags := types.SetValueFrom(ctx, types.StringType, response.Monitor.AcceptedStatusCodes)
-	resp.Diagnostics.Append(diags...)
-	kafakaProducerBrokers, diags := types.SetValueFrom(ctx, types.StringType, response.Monitor.KafkaProducerBrokers)
-	resp.Diagnostics.Append(diags...)
-	OAuthScopes, diags := types.SetValueFrom(ctx, types.StringType, response.Monitor.OAuthScopes)
-	resp.Diagnostics.Append(diags...)
-
-	var tags []tagInstanceDataModel
-	for _, tag := range response.Monitor.Tags {
-		tags = append(tags, tagInstanceDataModel{
-			ID:    types.Int64Value(tag.ID),
-			Name:  types.StringValue(tag.Name),
-			Value: types.StringValue(tag.Value),
-			Color: types.StringValue(tag.Color),
-		})
-	}
-
-	tout := monitorModel{
-		ID:                                  types.Int64Value(response.Monitor.ID),
-		Type:                                types.StringValue(response.Monitor.Type),
-		Name:                                types.StringValue(response.Monitor.Name),
-		Interval:                            types.Int64Value(response.Monitor.Interval),
-		RetryInterval:                       types.Int64Value(response.Monitor.RetryInterval),
-		ResendInterval:                      types.Int64Value(response.Monitor.ResendInterval),
-		MaxRetries:                          types.Int64Value(response.Monitor.MaxRetries),
-		UpsideDown:                          types.BoolValue(response.Monitor.UpsideDown),
-		NotificationIDList:                  notificationIDs,
-		URL:                                 types.StringValue(response.Monitor.URL),
-		ExpiryNotification:                  types.BoolValue(response.Monitor.ExpiryNotification),
-		IgnoreTls:                           types.BoolValue(response.Monitor.IgnoreTls),
-		MaxRedirects:                        types.Int64Value(response.Monitor.MaxRedirects),
-		AcceptedStatusCodes:                 acceptedStatusCodes,
-		ProxyID:                             types.Int64Value(response.Monitor.ProxyID),
-		Method:                              types.StringValue(response.Monitor.Method),
-		Body:                                types.StringValue(response.Monitor.Body),
-		Headers:                             types.StringValue(response.Monitor.Headers),
-		AuthMethod:                          types.StringValue(response.Monitor.AuthMethod),
-		BasicAuthUser:                       types.StringValue(response.Monitor.BasicAuthUser),
-		BasicAuthPass:                       types.StringValue(response.Monitor.BasicAuthPass),
-		AuthDomain:                          types.StringValue(response.Monitor.AuthDomain),
-		AuthWorkstation:                     types.StringValue(response.Monitor.AuthWorkstation),
-		Keyword:                             types.StringValue(response.Monitor.Keyword),
-		Hostname:                            types.StringValue(response.Monitor.Hostname),
-		Port:                                types.Int64Value(response.Monitor.Port),
-		DNSResolveServer:                    types.StringValue(response.Monitor.DNSResolveServer),
-		DNSResolveType:                      types.StringValue(response.Monitor.DNSResolveType),
-		MQTTUsername:                        types.StringValue(response.Monitor.MQTTUsername),
-		MQTTPassword:                        types.StringValue(response.Monitor.MQTTPassword),
-		MQTTTopic:                           types.StringValue(response.Monitor.MQTTTopic),
-		MQTTSucessMessage:                   types.StringValue(response.Monitor.MQTTSucessMessage),
-		DatabaseConnectionString:            types.StringValue(response.Monitor.DatabaseConnectionString),
-		DatabaseQuery:                       types.StringValue(response.Monitor.DatabaseQuery),
-		DockerContainer:                     types.StringValue(response.Monitor.DockerContainer),
-		DockerHost:                          types.Int64Value(response.Monitor.DockerHost),
-		RadiusUsername:                      types.StringValue(response.Monitor.RadiusUsername),
-		RadiusPassword:                      types.StringValue(response.Monitor.RadiusPassword),
-		RadiusSecret:                        types.StringValue(response.Monitor.RadiusSecret),
-		RadiusCalledStationId:               types.StringValue(response.Monitor.RadiusCalledStationId),
-		RadiusCallingStationId:              types.StringValue(response.Monitor.RadiusCallingStationId),
-		Active:                              types.BoolValue(response.Monitor.Active),
-		ForceInactive:                       types.BoolValue(response.Monitor.ForceInactive),
-		Game:                                types.StringValue(response.Monitor.Game),
-		GamedigGivenPortOnly:                types.BoolValue(response.Monitor.GamedigGivenPortOnly),
-		GrpcBody:                            types.StringValue(response.Monitor.GrpcBody),
-		GrpcEnableTls:                       types.BoolValue(response.Monitor.GrpcEnableTls),
-		GrpcMetadata:                        types.StringValue(response.Monitor.GrpcMetadata),
-		GrpcMethod:                          types.StringValue(response.Monitor.GrpcMethod),
-		GrpcProtobuf:                        types.StringValue(response.Monitor.GrpcProtobuf),
-		GrpcServiceName:                     types.StringValue(response.Monitor.GrpcServiceName),
-		GrpcUrl:                             types.StringValue(response.Monitor.GrpcUrl),
-		HttpBodyEncoding:                    types.StringValue(response.Monitor.HttpBodyEncoding),
-		IncludeSensitiveData:                types.BoolValue(response.Monitor.IncludeSensitiveData),
-		InvertKeyword:                       types.BoolValue(response.Monitor.InvertKeyword),
-		JsonPath:                            types.StringValue(response.Monitor.JsonPath),
-		KafkaProducerAllowAutoTopicCreation: types.BoolValue(response.Monitor.KafkaProducerAllowAutoTopicCreation),
-		KafkaProducerBrokers:                kafakaProducerBrokers,
-		KafkaProducerMessage:                types.StringValue(response.Monitor.KafkaProducerMessage),
-		KafkaProducerSaslOptions:            types.StringValue(response.Monitor.KafkaProducerSaslOptions),
-		KafkaProducerSsl:                    types.BoolValue(response.Monitor.KafkaProducerSsl),
-		KafkaProducerTopic:                  types.StringValue(response.Monitor.KafkaProducerTopic),
-		Maintenance:                         types.BoolValue(response.Monitor.Maintenance),
-		OAuthAuthMethod:                     types.StringValue(response.Monitor.OAuthAuthMethod),
-		OAuthClientID:                       types.StringValue(response.Monitor.OAuthClientID),
-		OAuthClientSecret:                   types.StringValue(response.Monitor.OAuthClientSecret),
-		OAuthScopes:                         OAuthScopes,
-		OAuthTokenURL:                       types.StringValue(response.Monitor.OAuthTokenURL),
-		PacketSize:                          types.Int64Value(response.Monitor.PacketSize),
-		Parent:                              types.StringValue(response.Monitor.Parent),
-		PathName:                            types.StringValue(response.Monitor.PathName),
-		PushToken:                           types.StringValue(response.Monitor.PushToken),
-		Screenshot:                          types.StringValue(response.Monitor.Screenshot),
-		Tags:                                tags,
-		Timeout:                             types.Int64Value(response.Monitor.Timeout),
-		TlsCa:                               types.StringValue(response.Monitor.TlsCa),
-		TlsCert:                             types.StringValue(response.Monitor.TlsCert),
-		TlsKey:                              types.StringValue(response.Monitor.TlsKey),
-		Weight:                              types.Int64Value(response.Monitor.Weight),
+	resp.Diagnostics.Append(setMonitorModel(ctx, &state, monitor, computedAttributes(ctx, resp.State.Schema))...)
+	state.Tags = monitorTags(monitor)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	diags = resp.State.Set(ctx, &tout)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -0,0 +1,435 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+// Monitor models are mapped onto kumaclient.Monitor through struct tags
+// rather than by hand. Every model field that corresponds to an API field
+// carries a kuma tag naming the JSON field of kumaclient.Monitor, which is
+// also the attribute's name in the original flat schema:
+//
+//	URL types.String `tfsdk:"url" kuma:"url"`
+//
+// Sets of IDs add the element type, as in kuma:"notification_id_list,int64";
+// other sets hold strings. Nested blocks are pointers to structs whose fields
+// are tagged the same way, and embedded structs are flattened. Fields tagged
+// kuma:"-", such as tags, are left to the caller; a field with no kuma tag at
+// all is reported as an error, so a new attribute cannot be dropped silently.
+
+// mappedField is a model field that maps onto a kumaclient.Monitor field.
+type mappedField struct {
+	path  path.Path
+	kuma  string
+	elem  attr.Type // element type, for sets
+	value reflect.Value
+}
+
+// monitorJSONFields indexes the fields of kumaclient.Monitor by JSON name.
+var monitorJSONFields = func() map[string]int {
+	out := map[string]int{}
+
+	t := reflect.TypeOf(kumaclient.Monitor{})
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		out[name] = i
+	}
+
+	return out
+}()
+
+// mappedFields returns the kuma-tagged fields of the struct model points to,
+// in declaration order. Nil blocks are skipped.
+func mappedFields(model any) []mappedField {
+	var out []mappedField
+	walkMappedFields(reflect.ValueOf(model).Elem(), path.Empty(), &out)
+
+	return out
+}
+
+func walkMappedFields(v reflect.Value, base path.Path, out *[]mappedField) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		fv := v.Field(i)
+
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			walkMappedFields(fv, base, out)
+			continue
+		}
+
+		name := f.Tag.Get("tfsdk")
+		if name == "" {
+			continue
+		}
+
+		if f.Type.Kind() == reflect.Pointer && f.Type.Elem().Kind() == reflect.Struct {
+			if !fv.IsNil() {
+				walkMappedFields(fv.Elem(), base.AtName(name), out)
+			}
+			continue
+		}
+
+		tag := f.Tag.Get("kuma")
+		if tag == "-" {
+			continue
+		}
+
+		kuma, opt, _ := strings.Cut(tag, ",")
+		field := mappedField{
+			path:  base.AtName(name),
+			kuma:  kuma,
+			elem:  types.StringType,
+			value: fv,
+		}
+		if opt == "int64" {
+			field.elem = types.Int64Type
+		}
+
+		*out = append(*out, field)
+	}
+}
+
+// mappedBlock is a nested block of a monitor model.
+type mappedBlock struct {
+	name  string
+	value reflect.Value
+}
+
+// blockFields returns the nested block pointers of the struct model points
+// to, so callers can allocate and prune them.
+func blockFields(model any) []mappedBlock {
+	var out []mappedBlock
+
+	var walk func(v reflect.Value)
+	walk = func(v reflect.Value) {
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			switch {
+			case f.Anonymous && f.Type.Kind() == reflect.Struct:
+				walk(v.Field(i))
+			case f.Type.Kind() == reflect.Pointer && f.Type.Elem().Kind() == reflect.Struct && f.Tag.Get("tfsdk") != "":
+				out = append(out, mappedBlock{name: f.Tag.Get("tfsdk"), value: v.Field(i)})
+			}
+		}
+	}
+	walk(reflect.ValueOf(model).Elem())
+
+	return out
+}
+
+// monitorFromModel builds the API representation of the monitor described by
+// model. Null and unknown attributes are left unset, so they are not sent and
+// Uptime Kuma applies its own defaults.
+func monitorFromModel(ctx context.Context, model any) (kumaclient.Monitor, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var mon kumaclient.Monitor
+
+	target := reflect.ValueOf(&mon).Elem()
+	for _, f := range mappedFields(model) {
+		dst, ok := apiField(target, f, &diags)
+		if !ok {
+			continue
+		}
+
+		if v, ok := f.value.Interface().(attr.Value); ok && (v.IsNull() || v.IsUnknown()) {
+			continue
+		}
+
+		switch v := f.value.Interface().(type) {
+		case types.String:
+			setAPIValue(dst, v.ValueString())
+		case types.Int64:
+			setAPIValue(dst, v.ValueInt64())
+		case types.Bool:
+			setAPIValue(dst, v.ValueBool())
+		case types.Set:
+			elems := reflect.New(dst.Type())
+			diags.Append(v.ElementsAs(ctx, elems.Interface(), false)...)
+			dst.Set(elems.Elem())
+		default:
+			diags.AddAttributeError(f.path, "Unsupported Monitor Attribute Type",
+				fmt.Sprintf("The provider cannot map %T onto the API. Please report this issue to the provider developers.", v))
+		}
+	}
+
+	return mon, diags
+}
+
+// setMonitorModel copies mon onto model. Attributes that are null in model
+// and empty in mon stay null unless computed reports them as Computed, so
+// optional settings left out of the configuration do not show up as a diff
+// while those with a default pick up the value Uptime Kuma stored. Blocks
+// missing from model stay missing, as Terraform rejects a result with blocks
+// that were not planned.
+func setMonitorModel(ctx context.Context, model any, mon *kumaclient.Monitor, computed func(path.Path) bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	source := reflect.ValueOf(mon).Elem()
+	for _, f := range mappedFields(model) {
+		src, ok := apiField(source, f, &diags)
+		if !ok {
+			continue
+		}
+		src = apiValue(src)
+
+		prior, _ := f.value.Interface().(attr.Value)
+		keepNull := prior != nil && prior.IsNull() && !computed(f.path)
+
+		switch prior.(type) {
+		case types.String:
+			f.value.Set(reflect.ValueOf(stringAttr(keepNull, src.String())))
+		case types.Int64:
+			f.value.Set(reflect.ValueOf(int64Attr(keepNull, src.Int())))
+		case types.Bool:
+			f.value.Set(reflect.ValueOf(boolAttr(keepNull, src.Bool())))
+		case types.Set:
+			var set types.Set
+			var d diag.Diagnostics
+			switch elems := src.Interface().(type) {
+			case []int64:
+				set, d = setAttr(ctx, keepNull, f.elem, elems)
+			case []string:
+				set, d = setAttr(ctx, keepNull, f.elem, elems)
+			}
+			diags.Append(d...)
+			f.value.Set(reflect.ValueOf(set))
+		default:
+			diags.AddAttributeError(f.path, "Unsupported Monitor Attribute Type",
+				fmt.Sprintf("The provider cannot map %T from the API. Please report this issue to the provider developers.", f.value.Interface()))
+		}
+	}
+
+	return diags
+}
+
+// setImportedMonitorModel is setMonitorModel for a monitor adopted by import,
+// whose state holds nothing but the ID. It also fills in the blocks that the
+// monitor's type uses and that hold a value.
+func setImportedMonitorModel(ctx context.Context, model any, mon *kumaclient.Monitor, computed func(path.Path) bool) diag.Diagnostics {
+	allocated := allocateBlocks(model)
+	diags := setMonitorModel(ctx, model, mon, computed)
+	pruneBlocks(allocated, mon.Type)
+
+	return diags
+}
+
+// computedAttributes returns a function reporting whether the attribute at a
+// path of s, the schema of a request's plan or state, is Computed.
+func computedAttributes(ctx context.Context, s any) func(path.Path) bool {
+	return func(p path.Path) bool {
+		switch sch := s.(type) {
+		case schema.Schema:
+			a, diags := sch.AttributeAtPath(ctx, p)
+			return !diags.HasError() && a.IsComputed()
+		case dsschema.Schema:
+			a, diags := sch.AttributeAtPath(ctx, p)
+			return !diags.HasError() && a.IsComputed()
+		}
+
+		return false
+	}
+}
+
+// monitorModelFromFlatState fills model from the raw JSON state of the
+// original flat monitor schema, whose attribute names are the kuma tags.
+func monitorModelFromFlatState(ctx context.Context, model any, raw []byte) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var state map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &state); err != nil {
+		diags.AddError("Unable to Upgrade Monitor State", "Decoding the prior state: "+err.Error())
+		return diags
+	}
+
+	allocated := allocateBlocks(model)
+
+	for _, f := range mappedFields(model) {
+		data, ok := state[f.kuma]
+		if !ok || string(data) == "null" {
+			data = nil
+		}
+
+		var err error
+		switch f.value.Interface().(type) {
+		case types.String:
+			v := types.StringNull()
+			if data != nil {
+				var s string
+				err = json.Unmarshal(data, &s)
+				v = types.StringValue(s)
+			}
+			f.value.Set(reflect.ValueOf(v))
+		case types.Int64:
+			v := types.Int64Null()
+			if data != nil {
+				var n int64
+				err = json.Unmarshal(data, &n)
+				v = types.Int64Value(n)
+			}
+			f.value.Set(reflect.ValueOf(v))
+		case types.Bool:
+			v := types.BoolNull()
+			if data != nil {
+				var b bool
+				err = json.Unmarshal(data, &b)
+				v = types.BoolValue(b)
+			}
+			f.value.Set(reflect.ValueOf(v))
+		case types.Set:
+			v := types.SetNull(f.elem)
+			if data != nil {
+				var d diag.Diagnostics
+				if f.elem == types.Int64Type {
+					var ids []int64
+					err = json.Unmarshal(data, &ids)
+					v, d = types.SetValueFrom(ctx, f.elem, ids)
+				} else {
+					var elems []string
+					err = json.Unmarshal(data, &elems)
+					v, d = types.SetValueFrom(ctx, f.elem, elems)
+				}
+				diags.Append(d...)
+			}
+			f.value.Set(reflect.ValueOf(v))
+		}
+
+		if err != nil {
+			diags.AddAttributeError(f.path, "Unable to Upgrade Monitor State",
+				fmt.Sprintf("Decoding %s from the prior state: %s", f.kuma, err))
+		}
+	}
+
+	var monitorType string
+	_ = json.Unmarshal(state["type"], &monitorType)
+	pruneBlocks(allocated, monitorType)
+
+	return diags
+}
+
+// apiField returns the kumaclient.Monitor field f maps onto.
+func apiField(mon reflect.Value, f mappedField, diags *diag.Diagnostics) (reflect.Value, bool) {
+	if f.kuma == "" {
+		diags.AddAttributeError(f.path, "Unmapped Monitor Attribute",
+			"The provider does not map this attribute onto the API. Please report this issue to the provider developers.")
+		return reflect.Value{}, false
+	}
+
+	i, ok := monitorJSONFields[f.kuma]
+	if !ok {
+		diags.AddAttributeError(f.path, "Unknown Monitor API Field",
+			fmt.Sprintf("The provider maps this attribute onto %q, which is not a monitor API field. Please report this issue to the provider developers.", f.kuma))
+		return reflect.Value{}, false
+	}
+
+	return mon.Field(i), true
+}
+
+// setAPIValue stores v in dst, a kumaclient.Monitor field that is either of
+// v's type or a pointer to it.
+func setAPIValue[T any](dst reflect.Value, v T) {
+	if dst.Kind() == reflect.Pointer {
+		dst.Set(reflect.ValueOf(&v))
+		return
+	}
+
+	dst.Set(reflect.ValueOf(v))
+}
+
+// apiValue dereferences a pointer kumaclient.Monitor field, reading a nil
+// one as the zero value.
+func apiValue(src reflect.Value) reflect.Value {
+	if src.Kind() != reflect.Pointer {
+		return src
+	}
+	if src.IsNil() {
+		return reflect.Zero(src.Type().Elem())
+	}
+
+	return src.Elem()
+}
+
+// allocateBlocks allocates every nil block of model and returns them.
+func allocateBlocks(model any) []mappedBlock {
+	var allocated []mappedBlock
+	for _, b := range blockFields(model) {
+		if b.value.IsNil() {
+			b.value.Set(reflect.New(b.value.Type().Elem()))
+			allocated = append(allocated, b)
+		}
+	}
+
+	return allocated
+}
+
+// pruneBlocks resets blocks back to nil when monitorType does not use them
+// or their attributes are all null.
+func pruneBlocks(blocks []mappedBlock, monitorType string) {
+	for _, b := range blocks {
+		if applies, ok := monitorTypeAttributes[b.name]; ok && !slices.Contains(applies, monitorType) {
+			b.value.Set(reflect.Zero(b.value.Type()))
+			continue
+		}
+
+		empty := true
+		for _, f := range mappedFields(b.value.Interface()) {
+			if v, ok := f.value.Interface().(attr.Value); ok && !v.IsNull() {
+				empty = false
+				break
+			}
+		}
+		if empty {
+			b.value.Set(reflect.Zero(b.value.Type()))
+		}
+	}
+}
+
+func stringAttr(keepNull bool, v string) types.String {
+	if keepNull && v == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(v)
+}
+
+func int64Attr(keepNull bool, v int64) types.Int64 {
+	if keepNull && v == 0 {
+		return types.Int64Null()
+	}
+
+	return types.Int64Value(v)
+}
+
+func boolAttr(keepNull bool, v bool) types.Bool {
+	if keepNull && !v {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(v)
+}
+
+func setAttr[T any](ctx context.Context, keepNull bool, elemType attr.Type, v []T) (types.Set, diag.Diagnostics) {
+	if keepNull && len(v) == 0 {
+		return types.SetNull(elemType), nil
+	}
+	if v == nil {
+		v = []T{}
+	}
+
+	return types.SetValueFrom(ctx, elemType, v)
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+func notComputed(path.Path) bool { return false }
+
+// fullModel returns a new model of the type model points to, with every block
+// allocated so that their fields are mapped too.
+func fullModel(model any) any {
+	full := reflect.New(reflect.TypeOf(model).Elem()).Interface()
+	allocateBlocks(full)
+
+	return full
+}
+
+// checkMappedFields reports kuma tags of model that do not name a field of
+// kumaclient.Monitor, and returns the fields mapped. Blocks may share a field,
+// as http and database do with ignore_tls.
+func checkMappedFields(t *testing.T, model any) map[string]bool {
+	t.Helper()
+
+	mapped := map[string]bool{}
+	for _, f := range mappedFields(fullModel(model)) {
+		if _, ok := monitorJSONFields[f.kuma]; !ok {
+			t.Errorf("%T maps %s onto %q, which is not a monitor API field", model, f.path, f.kuma)
+		}
+		mapped[f.kuma] = true
+	}
+
+	return mapped
+}
+
+// modelAttributes returns the tfsdk names of the fields of struct type t,
+// flattening embedded structs as the framework does.
+func modelAttributes(t reflect.Type) []string {
+	var out []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.Anonymous {
+			out = append(out, modelAttributes(f.Type)...)
+			continue
+		}
+		out = append(out, f.Tag.Get("tfsdk"))
+	}
+	slices.Sort(out)
+
+	return out
+}
+
+// The monitor resource and data source cover the whole API; tags are
+// managed apart from the mapping.
+func TestMonitorMappingCoversAPI(t *testing.T) {
+	for _, model := range []any{&monitorResourceModel{}, &monitorModel{}} {
+		mapped := checkMappedFields(t, model)
+		mapped["tags"] = true
+
+		for _, name := range sortedKeys(monitorJSONFields) {
+			if !mapped[name] {
+				t.Errorf("the API field %q is not mapped by %T", name, model)
+			}
+		}
+	}
+}
+
+func TestMonitorModelsMatchSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	var resourceSchema resource.SchemaResponse
+	NewMonitorResource().Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+	var dataSourceSchema datasource.SchemaResponse
+	NewMonitorDataSource().Schema(ctx, datasource.SchemaRequest{}, &dataSourceSchema)
+
+	check := func(name string, model any, attributes []string) {
+		t.Helper()
+		slices.Sort(attributes)
+		if got := modelAttributes(reflect.TypeOf(model).Elem()); !slices.Equal(got, attributes) {
+			t.Errorf("%s: model has attributes %v, schema has %v", name, got, attributes)
+		}
+	}
+
+	check("monitor resource", &monitorResourceModel{}, sortedKeys(resourceSchema.Schema.Attributes))
+	check("monitor data source", &monitorModel{}, sortedKeys(dataSourceSchema.Schema.Attributes))
+
+	for _, kind := range typedMonitorKinds {
+		var resp resource.SchemaResponse
+		(&typedMonitorResource{kind: kind}).Schema(ctx, resource.SchemaRequest{}, &resp)
+		check(kind.name, kind.newModel(), sortedKeys(resp.Schema.Attributes))
+	}
+}
+
+// Typed monitors map a subset of the API each, but every field they map must
+// exist. Their type is set by the resource.
+func TestTypedMonitorMapping(t *testing.T) {
+	for _, kind := range typedMonitorKinds {
+		t.Run(kind.name, func(t *testing.T) {
+			mapped := checkMappedFields(t, kind.newModel())
+			if len(kind.accepts) > 1 && !mapped["type"] {
+				t.Errorf("%s accepts several types but does not map type", kind.name)
+			}
+		})
+	}
+}
+
+func TestMonitorNotificationIDListRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	ids := types.SetValueMust(types.Int64Type, []attr.Value{types.Int64Value(4), types.Int64Value(1)})
+	model := monitorResourceModel{
+		Type:               types.StringValue("ping"),
+		Name:               types.StringValue("gateway"),
+		NotificationIDList: ids,
+	}
+
+	mon, diags := monitorFromModel(ctx, &model)
+	if diags.HasError() {
+		t.Fatalf("monitorFromModel: %v", diags)
+	}
+	got := slices.Clone(mon.NotificationIDList)
+	slices.Sort(got)
+	if want := []int64{1, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("NotificationIDList = %v, want %v", got, want)
+	}
+
+	var back monitorResourceModel
+	if diags := setMonitorModel(ctx, &back, &mon, notComputed); diags.HasError() {
+		t.Fatalf("setMonitorModel: %v", diags)
+	}
+	if !back.NotificationIDList.Equal(ids) {
+		t.Errorf("notification_id_list = %s, want %s", back.NotificationIDList, ids)
+	}
+}
+
+func TestMonitorNullAttributesRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	model := monitorResourceModel{
+		Type:               types.StringValue("http"),
+		Name:               types.StringValue("api"),
+		Interval:           types.Int64Unknown(),
+		MaxRetries:         types.Int64Value(0),
+		UpsideDown:         types.BoolValue(false),
+		NotificationIDList: types.SetNull(types.Int64Type),
+		HTTP: &monitorHTTPModel{
+			monitorHTTPSettingsModel: monitorHTTPSettingsModel{
+				URL:                 types.StringValue("https://example.com"),
+				Body:                types.StringValue(""),
+				AcceptedStatusCodes: types.SetNull(types.StringType),
+				OAuthScopes:         types.SetNull(types.StringType),
+			},
+		},
+	}
+
+	mon, diags := monitorFromModel(ctx, &model)
+	if diags.HasError() {
+		t.Fatalf("monitorFromModel: %v", diags)
+	}
+
+	data, err := json.Marshal(mon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var sent map[string]any
+	if err := json.Unmarshal(data, &sent); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]any{
+		"id":          float64(0),
+		"type":        "http",
+		"name":        "api",
+		"max_retries": float64(0),
+		"upside_down": false,
+		"url":         "https://example.com",
+		"body":        "",
+	}
+	if !reflect.DeepEqual(sent, want) {
+		t.Errorf("payload = %s, want only the configured fields %v", data, want)
+	}
+
+	var echoed kumaclient.Monitor
+	if err := json.Unmarshal(data, &echoed); err != nil {
+		t.Fatal(err)
+	}
+	echoed.ID = 7
+
+	// Read back onto the plan, as Create does. Null attributes stay null and
+	// unknown ones take the value the server stored.
+	back := model
+	back.HTTP = &monitorHTTPModel{}
+	*back.HTTP = *model.HTTP
+	if diags := setMonitorModel(ctx, &back, &echoed, notComputed); diags.HasError() {
+		t.Fatalf("setMonitorModel: %v", diags)
+	}
+
+	model.ID = types.Int64Value(7)
+	model.Interval = types.Int64Value(0)
+	if !reflect.DeepEqual(back, model) {
+		t.Errorf("round trip = %+v, want %+v", back, model)
+	}
+}
+
+func TestSetMonitorModelBlocks(t *testing.T) {
+	ctx := context.Background()
+
+	server, url := "1.1.1.1", "https://"
+	mon := &kumaclient.Monitor{
+		ID:               1,
+		Type:             "dns",
+		Name:             "resolver",
+		URL:              &url,
+		DNSResolveServer: &server,
+	}
+
+	var model monitorResourceModel
+	if diags := setMonitorModel(ctx, &model, mon, notComputed); diags.HasError() {
+		t.Fatalf("setMonitorModel: %v", diags)
+	}
+	if model.DNS != nil || model.HTTP != nil {
+		t.Errorf("setMonitorModel added blocks: dns = %v, http = %v", model.DNS, model.HTTP)
+	}
+
+	model = monitorResourceModel{DNS: &monitorDNSModel{}}
+	if diags := setMonitorModel(ctx, &model, mon, notComputed); diags.HasError() {
+		t.Fatalf("setMonitorModel: %v", diags)
+	}
+	if model.DNS == nil || model.DNS.ResolveServer.ValueString() != server {
+		t.Errorf("dns = %v, want the planned block filled in", model.DNS)
+	}
+
+	// After import every block the type uses is filled in, and only those.
+	model = monitorResourceModel{ID: types.Int64Value(1)}
+	if diags := setImportedMonitorModel(ctx, &model, mon, notComputed); diags.HasError() {
+		t.Fatalf("setImportedMonitorModel: %v", diags)
+	}
+	if model.DNS == nil || model.DNS.ResolveServer.ValueString() != server {
+		t.Errorf("dns = %v, want it filled in after import", model.DNS)
+	}
+	if model.HTTP != nil {
+		t.Errorf("http = %v, want no block for a dns monitor", model.HTTP)
+	}
+}
+
+// FuzzMonitorStringRoundTrip checks that string attributes reach the API and
+// come back unchanged, whatever they hold.
+func FuzzMonitorStringRoundTrip(f *testing.F) {
+	for _, s := range []string{"", "<xml>", `"<xml>"`, "<", "null", "unknown", `"quoted"`, "<nil>", "  padded  ", "ünïcödé", "line\nbreak"} {
+		f.Add(s)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		if !utf8.ValidString(s) {
+			t.Skip("Terraform strings are always valid UTF-8")
+		}
+		ctx := context.Background()
+
+		model := fullModel(&monitorResourceModel{}).(*monitorResourceModel)
+		for _, field := range mappedFields(model) {
+			if _, ok := field.value.Interface().(types.String); ok {
+				field.value.Set(reflect.ValueOf(types.StringValue(s)))
+			}
+		}
+
+		mon, diags := monitorFromModel(ctx, model)
+		if diags.HasError() {
+			t.Fatalf("monitorFromModel: %v", diags)
+		}
+
+		data, err := json.Marshal(mon)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var echoed kumaclient.Monitor
+		if err := json.Unmarshal(data, &echoed); err != nil {
+			t.Fatal(err)
+		}
+
+		back := fullModel(&monitorResourceModel{}).(*monitorResourceModel)
+		if diags := setMonitorModel(ctx, back, &echoed, notComputed); diags.HasError() {
+			t.Fatalf("setMonitorModel: %v", diags)
+		}
+
+		for _, field := range mappedFields(back) {
+			if v, ok := field.value.Interface().(types.String); ok && v.ValueString() != s {
+				t.Errorf("%s = %q, want %q", field.path, v.ValueString(), s)
+			}
+		}
+	})
+}
@@ -2,30 +2,35 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"strings"
+	"strconv"
 
-	"github.com/carlmjohnson/requests"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-)
-
-func cleanString(dirty string) string {
-
-	tout := strings.Trim(dirty, "\"")
-	tout = strings.TrimLeft(tout, "<")
-	tout = strings.TrimRight(tout, ">")
 
-	return tout
-}
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource              = &monitorResource{}
-	_ resource.ResourceWithConfigure = &monitorResource{}
+	_ resource.Resource                     = &monitorResource{}
+	_ resource.ResourceWithConfigure        = &monitorResource{}
+	_ resource.ResourceWithImportState      = &monitorResource{}
+	_ resource.ResourceWithConfigValidators = &monitorResource{}
+	_ resource.ResourceWithUpgradeState     = &monitorResource{}
 )
 
 // NewOrderResource is a helper function to simplify the provider implementation.
@@ -33,15 +38,141 @@ func NewMonitorResource() resource.Resource {
 	return &monitorResource{}
 }
 
-// monitorResource is the resource implementation.
-type monitorResource struct {
-	Host  string
-	Token string
+// monitorResourceModel maps the uptime-kuma_monitor schema. Settings that
+// only apply to some monitor types live in nested blocks; see
+// monitor_mapping.go for how the kuma tags map fields onto the API.
+type monitorResourceModel struct {
+	ID                   types.Int64       `tfsdk:"id" kuma:"id"`
+	Type                 types.String      `tfsdk:"type" kuma:"type"`
+	Name                 types.String      `tfsdk:"name" kuma:"name"`
+	Interval             types.Int64       `tfsdk:"interval" kuma:"interval"`
+	RetryInterval        types.Int64       `tfsdk:"retry_interval" kuma:"retry_interval"`
+	ResendInterval       types.Int64       `tfsdk:"resend_interval" kuma:"resend_interval"`
+	MaxRetries           types.Int64       `tfsdk:"max_retries" kuma:"max_retries"`
+	UpsideDown           types.Bool        `tfsdk:"upside_down" kuma:"upside_down"`
+	NotificationIDList   types.Set         `tfsdk:"notification_id_list" kuma:"notification_id_list,int64"`
+	Hostname             types.String      `tfsdk:"hostname" kuma:"hostname"`
+	Port                 types.Int64       `tfsdk:"port" kuma:"port"`
+	Keyword              types.String      `tfsdk:"keyword" kuma:"keyword"`
+	InvertKeyword        types.Bool        `tfsdk:"invert_keyword" kuma:"invert_keyword"`
+	Active               types.Bool        `tfsdk:"active" kuma:"active"`
+	ForceInactive        types.Bool        `tfsdk:"force_inactive" kuma:"force_inactive"`
+	Game                 types.String      `tfsdk:"game" kuma:"game"`
+	GamedigGivenPortOnly types.Bool        `tfsdk:"gamedig_given_port_only" kuma:"gamedig_given_port_only"`
+	IncludeSensitiveData types.Bool        `tfsdk:"include_sensitive_data" kuma:"include_sensitive_data"`
+	Maintenance          types.Bool        `tfsdk:"maintenance" kuma:"maintenance"`
+	PacketSize           types.Int64       `tfsdk:"packet_size" kuma:"packet_size"`
+	Parent               types.String      `tfsdk:"parent" kuma:"parent"`
+	PathName             types.String      `tfsdk:"path_name" kuma:"path_name"`
+	PushToken            types.String      `tfsdk:"push_token" kuma:"push_token"`
+	Screenshot           types.String      `tfsdk:"screenshot" kuma:"screenshot"`
+	Timeout              types.Int64       `tfsdk:"timeout" kuma:"timeout"`
+	Weight               types.Int64       `tfsdk:"weight" kuma:"weight"`
+	Tags                 []monitorTagModel `tfsdk:"tags" kuma:"-"`
+
+	HTTP          *monitorHTTPModel          `tfsdk:"http"`
+	DNS           *monitorDNSModel           `tfsdk:"dns"`
+	MQTT          *monitorMQTTModel          `tfsdk:"mqtt"`
+	Radius        *monitorRadiusModel        `tfsdk:"radius"`
+	GRPC          *monitorGRPCModel          `tfsdk:"grpc"`
+	KafkaProducer *monitorKafkaProducerModel `tfsdk:"kafka_producer"`
+	Database      *monitorDatabaseModel      `tfsdk:"database"`
+	Docker        *monitorDockerModel        `tfsdk:"docker"`
+}
+
+// monitorTagModel is a tag attached to the monitor, identified by the tag
+// and the value it carries on this monitor.
+type monitorTagModel struct {
+	TagID types.Int64  `tfsdk:"tag_id"`
+	Value types.String `tfsdk:"value"`
 }
 
-type resourceAuthData struct {
-	Host  string
-	Token string
+type monitorHTTPModel struct {
+	monitorHTTPSettingsModel
+	JsonPath types.String `tfsdk:"json_path" kuma:"json_path"`
+}
+
+// monitorHTTPSettingsModel holds the settings shared by every HTTP based
+// monitor.
+type monitorHTTPSettingsModel struct {
+	URL                 types.String `tfsdk:"url" kuma:"url"`
+	Method              types.String `tfsdk:"method" kuma:"method"`
+	Body                types.String `tfsdk:"body" kuma:"body"`
+	BodyEncoding        types.String `tfsdk:"body_encoding" kuma:"http_body_encoding"`
+	Headers             types.String `tfsdk:"headers" kuma:"headers"`
+	MaxRedirects        types.Int64  `tfsdk:"max_redirects" kuma:"max_redirects"`
+	AcceptedStatusCodes types.Set    `tfsdk:"accepted_statuscodes" kuma:"accepted_statuscodes"`
+	IgnoreTls           types.Bool   `tfsdk:"ignore_tls" kuma:"ignore_tls"`
+	ExpiryNotification  types.Bool   `tfsdk:"expiry_notification" kuma:"expiry_notification"`
+	ProxyID             types.Int64  `tfsdk:"proxy_id" kuma:"proxy_id"`
+	AuthMethod          types.String `tfsdk:"auth_method" kuma:"auth_method"`
+	BasicAuthUser       types.String `tfsdk:"basic_auth_user" kuma:"basic_auth_user"`
+	BasicAuthPass       types.String `tfsdk:"basic_auth_pass" kuma:"basic_auth_pass"`
+	AuthDomain          types.String `tfsdk:"auth_domain" kuma:"auth_domain"`
+	AuthWorkstation     types.String `tfsdk:"auth_workstation" kuma:"auth_workstation"`
+	OAuthAuthMethod     types.String `tfsdk:"oauth_auth_method" kuma:"oauth_auth_method"`
+	OAuthClientID       types.String `tfsdk:"oauth_client_id" kuma:"oauth_client_id"`
+	OAuthClientSecret   types.String `tfsdk:"oauth_client_secret" kuma:"oauth_client_secret"`
+	OAuthScopes         types.Set    `tfsdk:"oauth_scopes" kuma:"oauth_scopes"`
+	OAuthTokenURL       types.String `tfsdk:"oauth_token_url" kuma:"oauth_token_url"`
+	TlsCa               types.String `tfsdk:"tls_ca" kuma:"tls_ca"`
+	TlsCert             types.String `tfsdk:"tls_cert" kuma:"tls_cert"`
+	TlsKey              types.String `tfsdk:"tls_key" kuma:"tls_key"`
+}
+
+type monitorDNSModel struct {
+	ResolveServer types.String `tfsdk:"resolve_server" kuma:"dns_resolve_server"`
+	ResolveType   types.String `tfsdk:"resolve_type" kuma:"dns_resolve_type"`
+}
+
+type monitorMQTTModel struct {
+	Username       types.String `tfsdk:"username" kuma:"mqtt_username"`
+	Password       types.String `tfsdk:"password" kuma:"mqtt_password"`
+	Topic          types.String `tfsdk:"topic" kuma:"mqtt_topic"`
+	SuccessMessage types.String `tfsdk:"success_message" kuma:"mqtt_success_message"`
+}
+
+type monitorRadiusModel struct {
+	Username         types.String `tfsdk:"username" kuma:"radius_username"`
+	Password         types.String `tfsdk:"password" kuma:"radius_password"`
+	Secret           types.String `tfsdk:"secret" kuma:"radius_secret"`
+	CalledStationId  types.String `tfsdk:"called_station_id" kuma:"radius_called_station_id"`
+	CallingStationId types.String `tfsdk:"calling_station_id" kuma:"radius_calling_station_id"`
+}
+
+type monitorGRPCModel struct {
+	URL         types.String `tfsdk:"url" kuma:"grpc_url"`
+	ServiceName types.String `tfsdk:"service_name" kuma:"grpc_service_name"`
+	Method      types.String `tfsdk:"method" kuma:"grpc_method"`
+	Body        types.String `tfsdk:"body" kuma:"grpc_body"`
+	Metadata    types.String `tfsdk:"metadata" kuma:"grpc_metadata"`
+	Protobuf    types.String `tfsdk:"protobuf" kuma:"grpc_protobuf"`
+	EnableTls   types.Bool   `tfsdk:"enable_tls" kuma:"grpc_enable_tls"`
+}
+
+type monitorKafkaProducerModel struct {
+	Brokers                types.Set    `tfsdk:"brokers" kuma:"kafka_producer_brokers"`
+	Topic                  types.String `tfsdk:"topic" kuma:"kafka_producer_topic"`
+	Message                types.String `tfsdk:"message" kuma:"kafka_producer_message"`
+	Ssl                    types.Bool   `tfsdk:"ssl" kuma:"kafka_producer_ssl"`
+	SaslOptions            types.String `tfsdk:"sasl_options" kuma:"kafka_producer_sasl_options"`
+	AllowAutoTopicCreation types.Bool   `tfsdk:"allow_auto_topic_creation" kuma:"kafka_producer_allow_auto_topic_creation"`
+}
+
+type monitorDatabaseModel struct {
+	ConnectionString types.String `tfsdk:"connection_string" kuma:"database_connection_string"`
+	Query            types.String `tfsdk:"query" kuma:"database_query"`
+	IgnoreTls        types.Bool   `tfsdk:"ignore_tls" kuma:"ignore_tls"`
+}
+
+type monitorDockerModel struct {
+	Container types.String `tfsdk:"container" kuma:"docker_container"`
+	Host      types.Int64  `tfsdk:"host" kuma:"docker_host"`
+}
+
+// monitorResource is the resource implementation.
+type monitorResource struct {
+	client *kumaclient.Client
 }
 
 // Configure adds the provider configured client to the data source.
@@ -52,16 +183,16 @@ func (d *monitorResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	authbytes, ok := req.ProviderData.([]byte)
+	client, ok := req.ProviderData.(*kumaclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected []byte, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	json.Unmarshal(authbytes, &d)
+	d.client = client
 
 	tflog.Info(ctx, "Received a good token! @monitor_resource.configure")
 }
@@ -73,16 +204,14 @@ func (r *monitorResource) Metadata(_ context.Context, req resource.MetadataReque
 
 // Schema defines the schema for the resource.
 func (r *monitorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	// defaultCodes, diags := types.SetValue(types.StringType, []attr.Value{types.StringValue("200")})
-	// resp.Diagnostics.Append(diags...)
-	// if resp.Diagnostics.HasError() {
-	// 	return
-	// }
-
 	resp.Schema = schema.Schema{
+		Version: 2,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.Int64Attribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
 			},
 			"type": schema.StringAttribute{
 				Required: true,
@@ -91,129 +220,50 @@ func (r *monitorResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 				Required: true,
 			},
 			"interval": schema.Int64Attribute{
-				Required: false,
 				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(60),
 			},
 			"retry_interval": schema.Int64Attribute{
 				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(60),
 			},
 			"resend_interval": schema.Int64Attribute{
 				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
 			},
 			"max_retries": schema.Int64Attribute{
 				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(0),
 			},
 			"upside_down": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
 			},
 			"notification_id_list": schema.SetAttribute{
 				ElementType: types.Int64Type,
 				Optional:    true,
 			},
-			"url": schema.StringAttribute{
-				Required: true,
-			},
-			"expiry_notification": schema.BoolAttribute{
-				Optional: true,
-			},
-			"ignore_tls": schema.BoolAttribute{
-				Optional: true,
-			},
-			"max_redirects": schema.Int64Attribute{
-				Optional: true,
-			},
-			"accepted_statuscodes": schema.SetAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"proxy_id": schema.Int64Attribute{
-				Optional: true,
-			},
-			"method": schema.StringAttribute{
-				Optional: true,
-			},
-			"body": schema.StringAttribute{
-				Optional: true,
-			},
-			"headers": schema.StringAttribute{
-				Optional: true,
-			},
-			"auth_method": schema.StringAttribute{
-				Optional: true,
-			},
-			"basic_auth_user": schema.StringAttribute{
-				Optional: true,
-			},
-			"basic_auth_pass": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"auth_domain": schema.StringAttribute{
-				Optional: true,
-			},
-			"auth_workstation": schema.StringAttribute{
-				Optional: true,
-			},
-			"keyword": schema.StringAttribute{
-				Optional: true,
-			},
 			"hostname": schema.StringAttribute{
 				Optional: true,
 			},
 			"port": schema.Int64Attribute{
 				Optional: true,
 			},
-			"dns_resolve_server": schema.StringAttribute{
-				Optional: true,
-			},
-			"dns_resolve_type": schema.StringAttribute{
-				Optional: true,
-			},
-			"mqtt_username": schema.StringAttribute{
-				Optional: true,
-			},
-			"mqtt_password": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"mqtt_topic": schema.StringAttribute{
-				Optional: true,
-			},
-			"mqtt_success_message": schema.StringAttribute{
-				Optional: true,
-			},
-			"database_connection_string": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"database_query": schema.StringAttribute{
-				Optional: true,
-			},
-			"docker_container": schema.StringAttribute{
-				Optional: true,
-			},
-			"docker_host": schema.Int64Attribute{
-				Optional: true,
-			},
-			"radius_username": schema.StringAttribute{
-				Optional: true,
-			},
-			"radius_password": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"radius_secret": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"radius_called_station_id": schema.StringAttribute{
+			"keyword": schema.StringAttribute{
 				Optional: true,
 			},
-			"radius_calling_station_id": schema.StringAttribute{
+			"invert_keyword": schema.BoolAttribute{
 				Optional: true,
 			},
 			"active": schema.BoolAttribute{
 				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(true),
 			},
 			"force_inactive": schema.BoolAttribute{
 				Optional: true,
@@ -224,78 +274,12 @@ func (r *monitorResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 			"gamedig_given_port_only": schema.BoolAttribute{
 				Optional: true,
 			},
-			"grpc_body": schema.StringAttribute{
-				Optional: true,
-			},
-			"grpc_enable_tls": schema.BoolAttribute{
-				Optional: true,
-			},
-			"grpc_metadata": schema.StringAttribute{
-				Optional: true,
-			},
-			"grpc_method": schema.StringAttribute{
-				Optional: true,
-			},
-			"grpc_protobuf": schema.StringAttribute{
-				Optional: true,
-			},
-			"grpc_service_name": schema.StringAttribute{
-				Optional: true,
-			},
-			"grpc_url": schema.StringAttribute{
-				Optional: true,
-			},
-			"http_body_encoding": schema.StringAttribute{
-				Optional: true,
-			},
 			"include_sensitive_data": schema.BoolAttribute{
 				Optional: true,
 			},
-			"invert_keyword": schema.BoolAttribute{
-				Optional: true,
-			},
-			"json_path": schema.StringAttribute{
-				Optional: true,
-			},
-			"kafka_producer_allow_auto_topic_creation": schema.BoolAttribute{
-				Optional: true,
-			},
-			"kafka_producer_brokers": schema.SetAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"kafka_producer_message": schema.StringAttribute{
-				Optional: true,
-			},
-			"kafka_producer_sasl_options": schema.StringAttribute{
-				Optional: true,
-			},
-			"kafka_producer_ssl": schema.BoolAttribute{
-				Optional: true,
-			},
-			"kafka_producer_topic": schema.StringAttribute{
-				Optional: true,
-			},
 			"maintenance": schema.BoolAttribute{
 				Optional: true,
 			},
-			"oauth_auth_method": schema.StringAttribute{
-				Optional: true,
-			},
-			"oauth_client_id": schema.StringAttribute{
-				Optional: true,
-			},
-			"oauth_client_secret": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
-			},
-			"oauth_scopes": schema.SetAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
-			"oauth_token_url": schema.StringAttribute{
-				Optional: true,
-			},
 			"packet_size": schema.Int64Attribute{
 				Optional: true,
 			},
@@ -311,33 +295,295 @@ func (r *monitorResource) Schema(ctx context.Context, _ resource.SchemaRequest,
 			"screenshot": schema.StringAttribute{
 				Optional: true,
 			},
-			"tags": schema.SetAttribute{
-				ElementType: types.StringType,
-				Optional:    true,
-			},
 			"timeout": schema.Int64Attribute{
 				Optional: true,
-			},
-			"tls_ca": schema.StringAttribute{
-				Optional: true,
-			},
-			"tls_cert": schema.StringAttribute{
-				Optional: true,
-			},
-			"tls_key": schema.StringAttribute{
-				Optional:  true,
-				Sensitive: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(48),
 			},
 			"weight": schema.Int64Attribute{
 				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(2000),
+			},
+			"tags": schema.SetNestedAttribute{
+				Description: "Tags attached to the monitor. Tags are only managed when this is set; an empty set removes them all.",
+				Optional:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"tag_id": schema.Int64Attribute{
+							Required: true,
+						},
+						"value": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  stringdefault.StaticString(""),
+						},
+					},
+				},
+			},
+			"http": schema.SingleNestedAttribute{
+				Description: "Settings of http, keyword, json-query and real-browser monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Optional: true,
+					},
+					"method": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("GET"),
+					},
+					"body": schema.StringAttribute{
+						Optional: true,
+					},
+					"body_encoding": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("json"),
+					},
+					"headers": schema.StringAttribute{
+						Optional: true,
+					},
+					"json_path": schema.StringAttribute{
+						Optional: true,
+					},
+					"max_redirects": schema.Int64Attribute{
+						Optional: true,
+						Computed: true,
+						Default:  int64default.StaticInt64(10),
+					},
+					"accepted_statuscodes": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+						Computed:    true,
+						Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{types.StringValue("200-299")})),
+						Validators: []validator.Set{
+							statusCodesValidator{},
+						},
+					},
+					"ignore_tls": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"expiry_notification": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"proxy_id": schema.Int64Attribute{
+						Optional: true,
+					},
+					"auth_method": schema.StringAttribute{
+						Optional: true,
+					},
+					"basic_auth_user": schema.StringAttribute{
+						Optional: true,
+					},
+					"basic_auth_pass": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"auth_domain": schema.StringAttribute{
+						Optional: true,
+					},
+					"auth_workstation": schema.StringAttribute{
+						Optional: true,
+					},
+					"oauth_auth_method": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("client_secret_basic"),
+					},
+					"oauth_client_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"oauth_client_secret": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"oauth_scopes": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"oauth_token_url": schema.StringAttribute{
+						Optional: true,
+					},
+					"tls_ca": schema.StringAttribute{
+						Optional: true,
+					},
+					"tls_cert": schema.StringAttribute{
+						Optional: true,
+					},
+					"tls_key": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+				},
+			},
+			"dns": schema.SingleNestedAttribute{
+				Description: "Settings of dns monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"resolve_server": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("1.1.1.1"),
+					},
+					"resolve_type": schema.StringAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  stringdefault.StaticString("A"),
+					},
+				},
+			},
+			"mqtt": schema.SingleNestedAttribute{
+				Description: "Settings of mqtt monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"topic": schema.StringAttribute{
+						Optional: true,
+					},
+					"success_message": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"radius": schema.SingleNestedAttribute{
+				Description: "Settings of radius monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"username": schema.StringAttribute{
+						Optional: true,
+					},
+					"password": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"secret": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"called_station_id": schema.StringAttribute{
+						Optional: true,
+					},
+					"calling_station_id": schema.StringAttribute{
+						Optional: true,
+					},
+				},
+			},
+			"grpc": schema.SingleNestedAttribute{
+				Description: "Settings of grpc-keyword monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						Optional: true,
+					},
+					"service_name": schema.StringAttribute{
+						Optional: true,
+					},
+					"method": schema.StringAttribute{
+						Optional: true,
+					},
+					"body": schema.StringAttribute{
+						Optional: true,
+					},
+					"metadata": schema.StringAttribute{
+						Optional: true,
+					},
+					"protobuf": schema.StringAttribute{
+						Optional: true,
+					},
+					"enable_tls": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+			},
+			"kafka_producer": schema.SingleNestedAttribute{
+				Description: "Settings of kafka-producer monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"brokers": schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"topic": schema.StringAttribute{
+						Optional: true,
+					},
+					"message": schema.StringAttribute{
+						Optional: true,
+					},
+					"ssl": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+					"sasl_options": schema.StringAttribute{
+						Optional: true,
+					},
+					"allow_auto_topic_creation": schema.BoolAttribute{
+						Optional: true,
+						Computed: true,
+						Default:  booldefault.StaticBool(false),
+					},
+				},
+			},
+			"database": schema.SingleNestedAttribute{
+				Description: "Settings of sqlserver, postgres, mysql, mongodb and redis monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"connection_string": schema.StringAttribute{
+						Optional:  true,
+						Sensitive: true,
+					},
+					"query": schema.StringAttribute{
+						Optional: true,
+					},
+					"ignore_tls": schema.BoolAttribute{
+						Description: "Skip TLS certificate verification; redis only.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"docker": schema.SingleNestedAttribute{
+				Description: "Settings of docker monitors.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"container": schema.StringAttribute{
+						Optional: true,
+					},
+					"host": schema.Int64Attribute{
+						Optional: true,
+					},
+				},
 			},
 		},
 	}
 }
 
+// ConfigValidators checks the configuration against the monitor type.
+func (r *monitorResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		monitorTypeValidator{},
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	var plan monitorModel
+	ctx = withRedaction(ctx)
+
+	var plan monitorResourceModel
 
 	tflog.Debug(ctx, "STAGE: map plan")
 	diags := req.Plan.Get(ctx, &plan)
@@ -346,152 +592,332 @@ func (r *monitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	var notificationIDs, acceptedStatusCodes []string
+	tflog.Debug(ctx, "STAGE: map json representation - NAME:"+plan.Name.ValueString())
 
-	diags = plan.NotificationIDList.ElementsAs(ctx, &notificationIDs, false)
-	resp.Diagnostics.Append(diags...)
-	diags = plan.AcceptedStatusCodes.ElementsAs(ctx, &notificationIDs, false)
+	makeMon, diags := monitorFromModel(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating monitor", map[string]any{"monitor": kumaclient.RedactedJSON(makeMon)})
 
-	tflog.Debug(ctx, "STAGE: map json representation - NAME:"+plan.Name.String()+"|"+cleanString(plan.Name.String()))
-
-	makeMon := JSON_monitorModel{
-		Type:                     cleanString(plan.Type.String()),
-		Name:                     cleanString(plan.Name.String()),
-		Interval:                 plan.Interval.ValueInt64(),
-		RetryInterval:            plan.RetryInterval.ValueInt64(),
-		ResendInterval:           plan.ResendInterval.ValueInt64(),
-		MaxRetries:               plan.MaxRetries.ValueInt64(),
-		UpsideDown:               plan.UpsideDown.ValueBool(),
-		NotificationIDList:       notificationIDs,
-		URL:                      cleanString(plan.URL.String()),
-		ExpiryNotification:       plan.ExpiryNotification.ValueBool(),
-		IgnoreTls:                plan.IgnoreTls.ValueBool(),
-		MaxRedirects:             plan.MaxRedirects.ValueInt64(),
-		AcceptedStatusCodes:      acceptedStatusCodes,
-		ProxyID:                  plan.ProxyID.ValueInt64(),
-		Method:                   cleanString(plan.Method.String()),
-		Body:                     cleanString(plan.Body.String()),
-		Headers:                  cleanString(plan.Headers.String()),
-		AuthMethod:               cleanString(plan.AuthMethod.String()),
-		BasicAuthUser:            cleanString(plan.BasicAuthUser.String()),
-		BasicAuthPass:            cleanString(plan.BasicAuthPass.String()),
-		AuthDomain:               cleanString(plan.AuthDomain.String()),
-		AuthWorkstation:          cleanString(plan.AuthWorkstation.String()),
-		Keyword:                  cleanString(plan.Keyword.String()),
-		Hostname:                 cleanString(plan.Hostname.String()),
-		Port:                     plan.Port.ValueInt64(),
-		DNSResolveServer:         cleanString(plan.DNSResolveServer.String()),
-		DNSResolveType:           cleanString(plan.DNSResolveType.String()),
-		MQTTUsername:             cleanString(plan.MQTTUsername.String()),
-		MQTTPassword:             cleanString(plan.MQTTPassword.String()),
-		MQTTTopic:                cleanString(plan.MQTTTopic.String()),
-		MQTTSucessMessage:        cleanString(plan.MQTTSucessMessage.String()),
-		DatabaseConnectionString: cleanString(plan.DatabaseConnectionString.String()),
-		DatabaseQuery:            cleanString(plan.DatabaseQuery.String()),
-		DockerContainer:          cleanString(plan.DockerContainer.String()),
-		DockerHost:               plan.DockerHost.ValueInt64(),
-		RadiusUsername:           cleanString(plan.RadiusUsername.String()),
-		RadiusPassword:           cleanString(plan.RadiusPassword.String()),
-		RadiusSecret:             cleanString(plan.RadiusSecret.String()),
-		RadiusCalledStationId:    cleanString(plan.RadiusCalledStationId.String()),
-		RadiusCallingStationId:   cleanString(plan.RadiusCallingStationId.String()),
-	}
-
-	debugJSON, err := json.Marshal(makeMon)
+	newMon, err := r.client.CreateMonitor(ctx, &makeMon)
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating new monitor (json encode)",
+			"Error creating new monitor (api call)",
 			"what we know: "+err.Error(),
 		)
 		return
 	}
 
-	tflog.Debug(ctx, "NEW MONITOR JSON: "+string(debugJSON))
+	if plan.Tags != nil {
+		newMon = r.syncMonitorTags(ctx, newMon, plan.Tags, &resp.Diagnostics)
+	}
 
-	var newMon JSON_monitorModel
-	err = requests.
-		URL(r.Host).
-		Bearer(r.Token).
-		Pathf("/monitors").
-		BodyJSON(&makeMon).
-		ToJSON(&newMon).
-		Fetch(ctx)
+	tflog.Debug(ctx, "STAGE: map new monitor onto schema")
+
+	// The monitor exists now, so its state is saved even if attaching tags
+	// failed; Terraform then marks it tainted.
+	resp.Diagnostics.Append(setMonitorModel(ctx, &plan, newMon, computedAttributes(ctx, resp.State.Schema))...)
+	plan.Tags = monitorTagModels(plan.Tags, newMon)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withRedaction(ctx)
+
+	var state monitorResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Requesting monitor %d", state.ID.ValueInt64()))
+	monitor, err := r.client.GetMonitor(ctx, state.ID.ValueInt64())
+	if kumaclient.IsNotFound(err) {
+		// Deleted outside of Terraform; dropping it from state makes the
+		// next plan create it again.
+		tflog.Warn(ctx, fmt.Sprintf("Monitor %d no longer exists, removing it from state", state.ID.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error creating new monitor (api call)",
+			"Error reading monitor (api call)",
 			"what we know: "+err.Error(),
 		)
 		return
 	}
 
-	tflog.Debug(ctx, "STAGE: map new monitor onto schema")
+	imported, diags := req.Private.GetKey(ctx, monitorImportedKey)
+	resp.Diagnostics.Append(diags...)
+
+	if len(imported) > 0 {
+		resp.Diagnostics.Append(setImportedMonitorModel(ctx, &state, monitor, computedAttributes(ctx, resp.State.Schema))...)
+		// Import cannot see whether tags are configured, so record the
+		// monitor's tags if it has any; without tags, null keeps the next
+		// plan clean when tags is not configured.
+		if len(monitor.Tags) > 0 {
+			state.Tags = []monitorTagModel{}
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, monitorImportedKey, nil)...)
+	} else {
+		resp.Diagnostics.Append(setMonitorModel(ctx, &state, monitor, computedAttributes(ctx, resp.State.Schema))...)
+	}
+	state.Tags = monitorTagModels(state.Tags, monitor)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withRedaction(ctx)
+
+	var plan, state monitorResourceModel
 
-	newNotificationIDList, diags := types.SetValueFrom(ctx, types.StringType, newMon.NotificationIDList)
+	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
-	newAcceptedStatusCodes, diags := types.SetValueFrom(ctx, types.StringType, newMon.AcceptedStatusCodes)
+	diags = req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	resultMon := monitorModel{
-		ID:                       types.Int64Value(newMon.ID),
-		Type:                     types.StringValue(newMon.Type),
-		Name:                     types.StringValue(newMon.Name),
-		Interval:                 types.Int64Value(newMon.Interval),
-		RetryInterval:            types.Int64Value(newMon.RetryInterval),
-		ResendInterval:           types.Int64Value(newMon.ResendInterval),
-		MaxRetries:               types.Int64Value(newMon.MaxRetries),
-		UpsideDown:               types.BoolValue(newMon.UpsideDown),
-		NotificationIDList:       newNotificationIDList,
-		URL:                      types.StringValue(newMon.URL),
-		ExpiryNotification:       types.BoolValue(newMon.ExpiryNotification),
-		IgnoreTls:                types.BoolValue(newMon.IgnoreTls),
-		MaxRedirects:             types.Int64Value(newMon.MaxRedirects),
-		AcceptedStatusCodes:      newAcceptedStatusCodes,
-		ProxyID:                  types.Int64Value(newMon.ProxyID),
-		Method:                   types.StringValue(newMon.Method),
-		Body:                     types.StringValue(newMon.Body),
-		Headers:                  types.StringValue(newMon.Headers),
-		AuthMethod:               types.StringValue(newMon.AuthMethod),
-		BasicAuthUser:            types.StringValue(newMon.BasicAuthUser),
-		BasicAuthPass:            types.StringValue(newMon.BasicAuthPass),
-		AuthDomain:               types.StringValue(newMon.AuthDomain),
-		AuthWorkstation:          types.StringValue(newMon.AuthWorkstation),
-		Keyword:                  types.StringValue(newMon.Keyword),
-		Hostname:                 types.StringValue(newMon.Hostname),
-		Port:                     types.Int64Value(newMon.Port),
-		DNSResolveServer:         types.StringValue(newMon.DNSResolveServer),
-		DNSResolveType:           types.StringValue(newMon.DNSResolveType),
-		MQTTUsername:             types.StringValue(newMon.MQTTUsername),
-		MQTTPassword:             types.StringValue(newMon.MQTTPassword),
-		MQTTTopic:                types.StringValue(newMon.MQTTTopic),
-		MQTTSucessMessage:        types.StringValue(newMon.MQTTSucessMessage),
-		DatabaseConnectionString: types.StringValue(newMon.DatabaseConnectionString),
-		DatabaseQuery:            types.StringValue(newMon.DatabaseQuery),
-		DockerContainer:          types.StringValue(newMon.DockerContainer),
-		DockerHost:               types.Int64Value(newMon.DockerHost),
-		RadiusUsername:           types.StringValue(newMon.RadiusUsername),
-		RadiusPassword:           types.StringValue(newMon.RadiusPassword),
-		RadiusSecret:             types.StringValue(newMon.RadiusSecret),
-		RadiusCalledStationId:    types.StringValue(newMon.RadiusCalledStationId),
-		RadiusCallingStationId:   types.StringValue(newMon.RadiusCallingStationId),
-	}
-
-	diags = resp.State.Set(ctx, resultMon)
+	plan.ID = state.ID
+
+	editMon, diags := monitorFromModel(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-}
+	tflog.Debug(ctx, "Updating monitor", map[string]any{"monitor": kumaclient.RedactedJSON(editMon)})
 
-// Read refreshes the Terraform state with the latest data.
-func (r *monitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
-}
+	updatedMon, err := r.client.UpdateMonitor(ctx, state.ID.ValueInt64(), &editMon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
 
-// Update updates the resource and sets the updated Terraform state on success.
-func (r *monitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	if plan.Tags != nil {
+		updatedMon = r.syncMonitorTags(ctx, updatedMon, plan.Tags, &resp.Diagnostics)
+	}
+
+	resp.Diagnostics.Append(setMonitorModel(ctx, &plan, updatedMon, computedAttributes(ctx, resp.State.Schema))...)
+	plan.Tags = monitorTagModels(plan.Tags, updatedMon)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *monitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withRedaction(ctx)
+
+	var state monitorResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting monitor %d", state.ID.ValueInt64()))
+	err := r.client.DeleteMonitor(ctx, state.ID.ValueInt64())
+	if kumaclient.IsNotFound(err) {
+		// Already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+}
+
+// monitorImportedKey marks, in private state, a monitor that ImportState has
+// adopted and Read has not filled in yet.
+const monitorImportedKey = "imported"
+
+// ImportState adopts an existing monitor by its numeric ID; Read fills in the
+// rest of the attributes.
+func (r *monitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the numeric ID of an Uptime Kuma monitor, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, monitorImportedKey, []byte("true"))...)
+}
+
+// UpgradeState moves state written by the flat schema (version 0) into the
+// nested blocks. Its tags were plain strings and are dropped; they are
+// refreshed by the next Read when they are configured.
+func (r *monitorResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Monitor State", "The prior state is empty.")
+					return
+				}
+
+				var state monitorResourceModel
+				resp.Diagnostics.Append(monitorModelFromFlatState(ctx, &state, req.RawState.JSON)...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// syncMonitorTags attaches and detaches tags until mon carries exactly the
+// desired ones, and returns the monitor as stored afterwards. Failures are
+// added to diags; the monitor is still returned so the caller can record
+// what did change.
+func (r *monitorResource) syncMonitorTags(ctx context.Context, mon *kumaclient.Monitor, desired []monitorTagModel, diags *diag.Diagnostics) *kumaclient.Monitor {
+	type tagKey struct {
+		id    int64
+		value string
+	}
+
+	want := map[tagKey]bool{}
+	for _, t := range desired {
+		want[tagKey{t.TagID.ValueInt64(), t.Value.ValueString()}] = true
+	}
+
+	changed := false
+	have := map[tagKey]bool{}
+	for _, t := range mon.Tags {
+		k := tagKey{t.ID, t.Value}
+		have[k] = true
+		if want[k] {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Detaching tag %d from monitor %d", t.ID, mon.ID))
+		err := r.client.DeleteMonitorTag(ctx, t.ID, mon.ID, t.Value)
+		if err != nil && !kumaclient.IsNotFound(err) {
+			diags.AddError(
+				"Error detaching monitor tag (api call)",
+				fmt.Sprintf("tag %d: what we know: %s", t.ID, err),
+			)
+		}
+		changed = true
+	}
+
+	for _, t := range desired {
+		k := tagKey{t.TagID.ValueInt64(), t.Value.ValueString()}
+		if have[k] {
+			continue
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Attaching tag %d to monitor %d", k.id, mon.ID))
+		if err := r.client.AddMonitorTag(ctx, k.id, mon.ID, k.value); err != nil {
+			diags.AddError(
+				"Error attaching monitor tag (api call)",
+				fmt.Sprintf("tag %d: what we know: %s", k.id, err),
+			)
+		}
+		changed = true
+	}
+
+	if !changed {
+		return mon
+	}
+
+	refreshed, err := r.client.GetMonitor(ctx, mon.ID)
+	if err != nil {
+		diags.AddError(
+			"Error reading monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return mon
+	}
+
+	return refreshed
+}
+
+// monitorTagModels returns the tags of mon for the tags attribute, or nil
+// when configured is nil and tags are not managed.
+func monitorTagModels(configured []monitorTagModel, mon *kumaclient.Monitor) []monitorTagModel {
+	if configured == nil {
+		return nil
+	}
+
+	out := []monitorTagModel{}
+	for _, tag := range mon.Tags {
+		out = append(out, monitorTagModel{
+			TagID: types.Int64Value(tag.ID),
+			Value: types.StringValue(tag.Value),
+		})
+	}
+
+	return out
+}
+
+// monitorTagsAttribute is the read-only tags attribute of the typed monitor
+// resources.
+func monitorTagsAttribute() schema.Attribute {
+	return schema.SetNestedAttribute{
+		Description: "Tags attached to the monitor in Uptime Kuma.",
+		Computed:    true,
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.Int64Attribute{
+					Computed: true,
+				},
+				"name": schema.StringAttribute{
+					Computed: true,
+				},
+				"color": schema.StringAttribute{
+					Computed: true,
+				},
+				"value": schema.StringAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// monitorTags returns the tags attached to mon.
+func monitorTags(mon *kumaclient.Monitor) []tagInstanceDataModel {
+	var out []tagInstanceDataModel
+	for _, tag := range mon.Tags {
+		out = append(out, tagInstanceDataModel{
+			ID:    types.Int64Value(tag.ID),
+			Name:  types.StringValue(tag.Name),
+			Color: types.StringValue(tag.Color),
+			Value: types.StringValue(tag.Value),
+		})
+	}
+
+	return out
 }
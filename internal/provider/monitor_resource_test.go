@@ -0,0 +1,433 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+// fakeBridge is a REST bridge holding a single monitor with id 1. Like Uptime
+// Kuma, it fills in defaults for fields the request leaves out, including
+// those of other monitor types.
+type fakeBridge struct {
+	mu      sync.Mutex
+	monitor map[string]any
+	// tagCalls records the tags attached to and detached from the monitor,
+	// as "add 3 prod" or "delete 3 prod".
+	tagCalls []string
+}
+
+func newFakeBridge(t *testing.T, monitor map[string]any) (*fakeBridge, *kumaclient.Client) {
+	t.Helper()
+
+	b := &fakeBridge{monitor: monitor}
+	srv := httptest.NewServer(b)
+	t.Cleanup(srv.Close)
+
+	c, err := kumaclient.New(context.Background(), kumaclient.Config{Host: srv.URL, APIKey: "key"})
+	if err != nil {
+		t.Fatalf("kumaclient.New: %v", err)
+	}
+
+	return b, c
+}
+
+var fakeMonitorDefaults = map[string]any{
+	"interval":           60,
+	"retry_interval":     60,
+	"max_retries":        0,
+	"active":             true,
+	"timeout":            48,
+	"weight":             2000,
+	"url":                "https://",
+	"method":             "GET",
+	"dns_resolve_server": "1.1.1.1",
+	"dns_resolve_type":   "A",
+	"tags":               []any{},
+}
+
+func (b *fakeBridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/monitors":
+		b.monitor = map[string]any{}
+		for k, v := range fakeMonitorDefaults {
+			b.monitor[k] = v
+		}
+		_ = json.NewDecoder(r.Body).Decode(&b.monitor)
+		b.monitor["id"] = 1
+		_ = json.NewEncoder(w).Encode(map[string]any{"msg": "Added Successfully.", "monitorID": 1})
+	case r.Method == http.MethodGet && r.URL.Path == "/monitors/1" && b.monitor != nil:
+		_ = json.NewEncoder(w).Encode(map[string]any{"monitor": b.monitor})
+	case r.URL.Path == "/monitors/1/tag" && b.monitor != nil:
+		var req struct {
+			TagID int64  `json:"tag_id"`
+			Value string `json:"value"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&req)
+
+		tags, _ := b.monitor["tags"].([]any)
+		switch r.Method {
+		case http.MethodPost:
+			b.tagCalls = append(b.tagCalls, fmt.Sprintf("add %d %s", req.TagID, req.Value))
+			tags = append(tags, map[string]any{"tag_id": req.TagID, "name": "tag", "color": "#fff", "value": req.Value})
+		case http.MethodDelete:
+			b.tagCalls = append(b.tagCalls, fmt.Sprintf("delete %d %s", req.TagID, req.Value))
+			kept := []any{}
+			for _, t := range tags {
+				t := t.(map[string]any)
+				if fmt.Sprint(t["tag_id"]) != fmt.Sprint(req.TagID) || t["value"] != req.Value {
+					kept = append(kept, t)
+				}
+			}
+			tags = kept
+		}
+		b.monitor["tags"] = tags
+		_ = json.NewEncoder(w).Encode(map[string]any{"msg": "OK"})
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// monitorSchemaState returns an empty state for the schema of r, the monitor
+// resource or any other.
+func monitorSchemaState(t *testing.T, r resource.Resource) tfsdk.State {
+	t.Helper()
+
+	ctx := context.Background()
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Schema: %v", resp.Diagnostics)
+	}
+
+	return tfsdk.State{
+		Schema: resp.Schema,
+		Raw:    tftypes.NewValue(resp.Schema.Type().TerraformType(ctx), nil),
+	}
+}
+
+// dnsMonitorPlan is the plan of a dns monitor configured without a dns
+// block, with the schema defaults applied.
+func dnsMonitorPlan() monitorResourceModel {
+	return monitorResourceModel{
+		ID:                   types.Int64Unknown(),
+		Type:                 types.StringValue("dns"),
+		Name:                 types.StringValue("resolver"),
+		Hostname:             types.StringValue("example.com"),
+		Port:                 types.Int64Value(53),
+		Interval:             types.Int64Value(60),
+		RetryInterval:        types.Int64Value(60),
+		ResendInterval:       types.Int64Value(0),
+		MaxRetries:           types.Int64Value(0),
+		UpsideDown:           types.BoolValue(false),
+		Active:               types.BoolValue(true),
+		Timeout:              types.Int64Value(48),
+		Weight:               types.Int64Value(2000),
+		NotificationIDList:   types.SetNull(types.Int64Type),
+		Keyword:              types.StringNull(),
+		InvertKeyword:        types.BoolNull(),
+		ForceInactive:        types.BoolNull(),
+		Game:                 types.StringNull(),
+		GamedigGivenPortOnly: types.BoolNull(),
+		IncludeSensitiveData: types.BoolNull(),
+		Maintenance:          types.BoolNull(),
+		PacketSize:           types.Int64Null(),
+		Parent:               types.StringNull(),
+		PathName:             types.StringNull(),
+		PushToken:            types.StringNull(),
+		Screenshot:           types.StringNull(),
+	}
+}
+
+// A dns monitor without a dns block must not come back with one filled from
+// Uptime Kuma's defaults, or Terraform reports an inconsistent result.
+func TestMonitorCreateLeavesUnplannedBlocksOut(t *testing.T) {
+	ctx := context.Background()
+	_, client := newFakeBridge(t, nil)
+	r := &monitorResource{client: client}
+
+	empty := monitorSchemaState(t, r)
+	plan := tfsdk.Plan{Schema: empty.Schema, Raw: empty.Raw}
+	planModel := dnsMonitorPlan()
+	if diags := plan.Set(ctx, &planModel); diags.HasError() {
+		t.Fatalf("building the plan: %v", diags)
+	}
+
+	resp := resource.CreateResponse{State: empty}
+	r.Create(ctx, resource.CreateRequest{Plan: plan}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Create: %v", resp.Diagnostics)
+	}
+
+	var state monitorResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("reading the state: %v", diags)
+	}
+	if state.ID.ValueInt64() != 1 {
+		t.Errorf("id = %s, want 1", state.ID)
+	}
+	if state.DNS != nil {
+		t.Errorf("dns = %+v, want no block as none was planned", *state.DNS)
+	}
+	if state.HTTP != nil {
+		t.Errorf("http = %+v, want no block as none was planned", *state.HTTP)
+	}
+
+	// Refreshing must not add them either.
+	readResp := resource.ReadResponse{State: resp.State}
+	r.Read(ctx, resource.ReadRequest{State: resp.State}, &readResp)
+	if readResp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", readResp.Diagnostics)
+	}
+	if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("reading the state: %v", diags)
+	}
+	if state.DNS != nil || state.HTTP != nil {
+		t.Errorf("Read added blocks: dns = %v, http = %v", state.DNS, state.HTTP)
+	}
+}
+
+// readMissing reads r from a state that only holds id, as the fake bridge
+// answers 404, and fails unless Read removes the resource from state.
+func readMissing(t *testing.T, r resource.Resource, id any) {
+	t.Helper()
+
+	ctx := context.Background()
+	state := monitorSchemaState(t, r)
+	if diags := state.SetAttribute(ctx, path.Root("id"), id); diags.HasError() {
+		t.Fatalf("building the state: %v", diags)
+	}
+
+	resp := resource.ReadResponse{State: state}
+	r.Read(ctx, resource.ReadRequest{State: state}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("state = %s, want the resource removed", resp.State.Raw)
+	}
+}
+
+func TestMonitorReadRemovesMissing(t *testing.T) {
+	_, client := newFakeBridge(t, nil)
+	readMissing(t, &monitorResource{client: client}, int64(1))
+}
+
+// newPrivateData allocates an empty private state at field, as the framework
+// does before calling the resource.
+func newPrivateData[T any](field *T) {
+	v := reflect.ValueOf(field).Elem()
+	v.Set(reflect.New(v.Type().Elem()))
+}
+
+// dnsMonitor is a dns monitor as the REST bridge returns it, with tags.
+func dnsMonitor(tags ...any) map[string]any {
+	return map[string]any{
+		"id":                 1,
+		"type":               "dns",
+		"name":               "resolver",
+		"hostname":           "example.com",
+		"url":                "https://",
+		"dns_resolve_server": "1.1.1.1",
+		"dns_resolve_type":   "AAAA",
+		"tags":               append([]any{}, tags...),
+	}
+}
+
+func TestMonitorImportFillsTagsAndBlocks(t *testing.T) {
+	tests := map[string]struct {
+		monitor map[string]any
+		want    []monitorTagModel
+	}{
+		"with tags": {
+			monitor: dnsMonitor(map[string]any{"id": 10, "tag_id": 3, "name": "env", "color": "#fff", "value": "prod"}),
+			want:    []monitorTagModel{{TagID: types.Int64Value(3), Value: types.StringValue("prod")}},
+		},
+		// Left null, so a configuration without tags plans no change.
+		"without tags": {
+			monitor: dnsMonitor(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			_, client := newFakeBridge(t, tt.monitor)
+			r := &monitorResource{client: client}
+
+			importResp := resource.ImportStateResponse{State: monitorSchemaState(t, r)}
+			newPrivateData(&importResp.Private)
+			r.ImportState(ctx, resource.ImportStateRequest{ID: "1"}, &importResp)
+			if importResp.Diagnostics.HasError() {
+				t.Fatalf("ImportState: %v", importResp.Diagnostics)
+			}
+
+			readResp := resource.ReadResponse{State: importResp.State, Private: importResp.Private}
+			r.Read(ctx, resource.ReadRequest{State: importResp.State, Private: importResp.Private}, &readResp)
+			if readResp.Diagnostics.HasError() {
+				t.Fatalf("Read: %v", readResp.Diagnostics)
+			}
+
+			var state monitorResourceModel
+			if diags := readResp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("reading the state: %v", diags)
+			}
+
+			if !reflect.DeepEqual(state.Tags, tt.want) {
+				t.Errorf("tags = %v, want %v", state.Tags, tt.want)
+			}
+			if state.DNS == nil || state.DNS.ResolveType.ValueString() != "AAAA" {
+				t.Errorf("dns = %v, want the imported block", state.DNS)
+			}
+			if state.HTTP != nil {
+				t.Errorf("http = %v, want no block for a dns monitor", state.HTTP)
+			}
+
+			// The next refresh treats the state as any other and leaves
+			// unmanaged tags alone.
+			marker, diags := readResp.Private.GetKey(ctx, monitorImportedKey)
+			if diags.HasError() || marker != nil {
+				t.Errorf("import marker still set after Read: %s %v", marker, diags)
+			}
+		})
+	}
+}
+
+func TestSyncMonitorTags(t *testing.T) {
+	prod := map[string]any{"id": 10, "tag_id": 3, "name": "env", "color": "#fff", "value": "prod"}
+	team := map[string]any{"id": 11, "tag_id": 4, "name": "team", "color": "#000", "value": ""}
+	tag := func(id int64, value string) monitorTagModel {
+		return monitorTagModel{TagID: types.Int64Value(id), Value: types.StringValue(value)}
+	}
+
+	tests := map[string]struct {
+		have      []any
+		desired   []monitorTagModel
+		wantCalls []string
+		want      []monitorTagModel
+	}{
+		"add": {
+			desired:   []monitorTagModel{tag(3, "prod")},
+			wantCalls: []string{"add 3 prod"},
+			want:      []monitorTagModel{tag(3, "prod")},
+		},
+		"remove": {
+			have:      []any{prod, team},
+			desired:   []monitorTagModel{tag(4, "")},
+			wantCalls: []string{"delete 3 prod"},
+			want:      []monitorTagModel{tag(4, "")},
+		},
+		"remove all": {
+			have:      []any{prod},
+			desired:   []monitorTagModel{},
+			wantCalls: []string{"delete 3 prod"},
+			want:      []monitorTagModel{},
+		},
+		// A tag's value is part of its identity, so changing it detaches the
+		// old pair and attaches the new one.
+		"value change": {
+			have:      []any{prod},
+			desired:   []monitorTagModel{tag(3, "staging")},
+			wantCalls: []string{"delete 3 prod", "add 3 staging"},
+			want:      []monitorTagModel{tag(3, "staging")},
+		},
+		"unchanged": {
+			have:    []any{prod, team},
+			desired: []monitorTagModel{tag(4, ""), tag(3, "prod")},
+			want:    []monitorTagModel{tag(3, "prod"), tag(4, "")},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			b, client := newFakeBridge(t, dnsMonitor(tt.have...))
+			r := &monitorResource{client: client}
+
+			mon, err := client.GetMonitor(ctx, 1)
+			if err != nil {
+				t.Fatalf("GetMonitor: %v", err)
+			}
+
+			var diags diag.Diagnostics
+			mon = r.syncMonitorTags(ctx, mon, tt.desired, &diags)
+			if diags.HasError() {
+				t.Fatalf("syncMonitorTags: %v", diags)
+			}
+
+			if !reflect.DeepEqual(b.tagCalls, tt.wantCalls) {
+				t.Errorf("tag calls = %q, want %q", b.tagCalls, tt.wantCalls)
+			}
+			if got := monitorTagModels(tt.desired, mon); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("tags = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// Version 0 state is upgraded straight to the current schema, dropping its
+// plain string tags.
+func TestMonitorUpgradeStateFromV0(t *testing.T) {
+	ctx := context.Background()
+	r := &monitorResource{}
+
+	upgraders := r.UpgradeState(ctx)
+	if len(upgraders) != 1 {
+		t.Fatalf("got upgraders for %d versions, want only version 0", len(upgraders))
+	}
+
+	raw, err := json.Marshal(map[string]any{
+		"id":               1,
+		"type":             "dns",
+		"name":             "resolver",
+		"hostname":         "example.com",
+		"dns_resolve_type": "AAAA",
+		"interval":         60,
+		"tags":             []string{"prod"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp := resource.UpgradeStateResponse{State: monitorSchemaState(t, r)}
+	upgraders[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("UpgradeState: %v", resp.Diagnostics)
+	}
+
+	var state monitorResourceModel
+	if diags := resp.State.Get(ctx, &state); diags.HasError() {
+		t.Fatalf("reading the state: %v", diags)
+	}
+	if state.Tags != nil {
+		t.Errorf("tags = %v, want null", state.Tags)
+	}
+	if state.DNS == nil || state.DNS.ResolveType.ValueString() != "AAAA" {
+		t.Errorf("dns = %v, want the flat dns_resolve_type moved into the block", state.DNS)
+	}
+	if state.HTTP != nil {
+		t.Errorf("http = %v, want no block for a dns monitor", state.HTTP)
+	}
+	if state.Interval.ValueInt64() != 60 {
+		t.Errorf("interval = %s, want 60", state.Interval)
+	}
+}
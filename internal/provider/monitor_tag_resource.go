@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &monitorTagResource{}
+	_ resource.ResourceWithConfigure   = &monitorTagResource{}
+	_ resource.ResourceWithImportState = &monitorTagResource{}
+)
+
+// NewMonitorTagResource is a helper function to simplify the provider implementation.
+func NewMonitorTagResource() resource.Resource {
+	return &monitorTagResource{}
+}
+
+// monitorTagResource manages a single tag attached to a monitor, for
+// monitors whose tags attribute is left unset.
+type monitorTagResource struct {
+	client *kumaclient.Client
+}
+
+type monitorTagResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	MonitorID types.Int64  `tfsdk:"monitor_id"`
+	TagID     types.Int64  `tfsdk:"tag_id"`
+	Value     types.String `tfsdk:"value"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *monitorTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*kumaclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *monitorTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_tag"
+}
+
+// Schema defines the schema for the resource. Every attribute identifies the
+// association, so changing any of them replaces it.
+func (r *monitorTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Attaches a tag to a monitor. Leave the monitor's own tags attribute unset, or the two will undo each other's changes.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "monitor_id/tag_id/value",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"monitor_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"tag_id": schema.Int64Attribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Description: "Value of the tag on the monitor. It must match the value in Uptime Kuma exactly, case and whitespace " +
+					"included: the association is identified by it, and refreshing removes the resource from state when no tag " +
+					"with exactly this value is attached. Defaults to an empty value.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *monitorTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withRedaction(ctx)
+
+	var plan monitorTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, tagID, value := plan.MonitorID.ValueInt64(), plan.TagID.ValueInt64(), plan.Value.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Attaching tag %d to monitor %d", tagID, monitorID))
+	if err := r.client.AddMonitorTag(ctx, tagID, monitorID, value); err != nil {
+		resp.Diagnostics.AddError(
+			"Error attaching monitor tag (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(monitorTagID(monitorID, tagID, value))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data. The association
+// has no state of its own, so Read only checks that it still exists.
+func (r *monitorTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withRedaction(ctx)
+
+	var state monitorTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, tagID, value := state.MonitorID.ValueInt64(), state.TagID.ValueInt64(), state.Value.ValueString()
+
+	tflog.Debug(ctx, fmt.Sprintf("Requesting monitor %d", monitorID))
+	monitor, err := r.client.GetMonitor(ctx, monitorID)
+	if kumaclient.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Monitor %d no longer exists, removing its tag %d from state", monitorID, tagID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	attached := false
+	for _, tag := range monitor.Tags {
+		if tag.ID == tagID && tag.Value == value {
+			attached = true
+			break
+		}
+	}
+	if !attached {
+		tflog.Warn(ctx, fmt.Sprintf("Tag %d is no longer attached to monitor %d, removing it from state", tagID, monitorID))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(monitorTagID(monitorID, tagID, value))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update is never called with changes, since every attribute requires
+// replacement, but the framework needs it to store the plan.
+func (r *monitorTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan monitorTagResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *monitorTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withRedaction(ctx)
+
+	var state monitorTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	monitorID, tagID := state.MonitorID.ValueInt64(), state.TagID.ValueInt64()
+
+	tflog.Debug(ctx, fmt.Sprintf("Detaching tag %d from monitor %d", tagID, monitorID))
+	err := r.client.DeleteMonitorTag(ctx, tagID, monitorID, state.Value.ValueString())
+	if kumaclient.IsNotFound(err) {
+		// Already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error detaching monitor tag (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState adopts an existing association from an ID of the form
+// monitor_id/tag_id/value. The value may itself contain slashes.
+func (r *monitorTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected monitor_id/tag_id/value, got: %q", req.ID),
+		)
+		return
+	}
+
+	monitorID, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a numeric monitor ID in %q, got: %q", req.ID, parts[0]),
+		)
+		return
+	}
+	tagID, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected a numeric tag ID in %q, got: %q", req.ID, parts[1]),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("monitor_id"), monitorID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("tag_id"), tagID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("value"), parts[2])...)
+}
+
+// monitorTagID formats the ID of a monitor tag association.
+func monitorTagID(monitorID, tagID int64, value string) string {
+	return fmt.Sprintf("%d/%d/%s", monitorID, tagID, value)
+}
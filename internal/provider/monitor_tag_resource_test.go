@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestMonitorTagImportState(t *testing.T) {
+	tests := map[string]struct {
+		id        string
+		monitorID int64
+		tagID     int64
+		value     string
+		wantErr   bool
+	}{
+		"value":                  {id: "1/3/prod", monitorID: 1, tagID: 3, value: "prod"},
+		"value with slashes":     {id: "1/3/eu/west/1", monitorID: 1, tagID: 3, value: "eu/west/1"},
+		"empty value":            {id: "12/4/", monitorID: 12, tagID: 4, value: ""},
+		"value with spaces":      {id: "1/3/ prod ", monitorID: 1, tagID: 3, value: " prod "},
+		"missing value":          {id: "1/3", wantErr: true},
+		"monitor ID only":        {id: "1", wantErr: true},
+		"empty":                  {id: "", wantErr: true},
+		"non-numeric monitor":    {id: "web/3/prod", wantErr: true},
+		"non-numeric tag":        {id: "1/env/prod", wantErr: true},
+		"empty monitor ID":       {id: "/3/prod", wantErr: true},
+		"fractional tag ID":      {id: "1/3.5/prod", wantErr: true},
+		"value before the IDs":   {id: "prod/1/3", wantErr: true},
+		"out of range monitor":   {id: "99999999999999999999/3/prod", wantErr: true},
+		"tag ID with whitespace": {id: "1/ 3/prod", wantErr: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &monitorTagResource{}
+
+			resp := resource.ImportStateResponse{State: monitorSchemaState(t, r)}
+			r.ImportState(ctx, resource.ImportStateRequest{ID: tt.id}, &resp)
+
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatalf("ImportState(%q) succeeded, want an error", tt.id)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ImportState(%q): %v", tt.id, resp.Diagnostics)
+			}
+
+			var state monitorTagResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("reading the state: %v", diags)
+			}
+			if state.ID.ValueString() != tt.id {
+				t.Errorf("id = %s, want %q", state.ID, tt.id)
+			}
+			if state.MonitorID.ValueInt64() != tt.monitorID || state.TagID.ValueInt64() != tt.tagID || state.Value.ValueString() != tt.value {
+				t.Errorf("imported monitor %s, tag %s, value %s; want %d, %d, %q",
+					state.MonitorID, state.TagID, state.Value, tt.monitorID, tt.tagID, tt.value)
+			}
+		})
+	}
+}
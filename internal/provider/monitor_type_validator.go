@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Monitor types grouped by the settings they share.
+var (
+	httpMonitorTypes     = []string{"http", "keyword", "json-query", "real-browser"}
+	databaseMonitorTypes = []string{"sqlserver", "postgres", "mysql", "mongodb", "redis"}
+)
+
+// monitorTypeRequired lists the attributes each monitor type needs, as
+// paths such as "http.url". Its keys are the monitor types the provider
+// accepts.
+var monitorTypeRequired = map[string][]string{
+	"http":           {"http.url"},
+	"keyword":        {"http.url", "keyword"},
+	"json-query":     {"http.url", "http.json_path"},
+	"real-browser":   {"http.url"},
+	"grpc-keyword":   {"grpc.url", "keyword"},
+	"port":           {"hostname", "port"},
+	"ping":           {"hostname"},
+	"tailscale-ping": {"hostname"},
+	"dns":            {"hostname"},
+	"docker":         {"docker.container", "docker.host"},
+	"push":           {},
+	"group":          {},
+	"steam":          {"hostname", "port"},
+	"gamedig":        {"hostname", "port", "game"},
+	"mqtt":           {"hostname", "port", "mqtt.topic"},
+	"kafka-producer": {"kafka_producer.brokers", "kafka_producer.topic", "kafka_producer.message"},
+	"sqlserver":      {"database.connection_string"},
+	"postgres":       {"database.connection_string"},
+	"mysql":          {"database.connection_string"},
+	"mongodb":        {"database.connection_string"},
+	"redis":          {"database.connection_string"},
+	"radius": {
+		"hostname", "radius.username", "radius.password", "radius.secret",
+		"radius.called_station_id", "radius.calling_station_id",
+	},
+}
+
+// monitorTypeAttributes lists the monitor types each type-specific block or
+// attribute applies to. Anything not listed here is accepted for every type.
+var monitorTypeAttributes = map[string][]string{
+	"http":                    httpMonitorTypes,
+	"http.json_path":          {"json-query"},
+	"keyword":                 {"keyword", "grpc-keyword"},
+	"invert_keyword":          {"keyword", "grpc-keyword"},
+	"dns":                     {"dns"},
+	"docker":                  {"docker"},
+	"mqtt":                    {"mqtt"},
+	"radius":                  {"radius"},
+	"grpc":                    {"grpc-keyword"},
+	"kafka_producer":          {"kafka-producer"},
+	"database":                databaseMonitorTypes,
+	"database.ignore_tls":     {"redis"},
+	"game":                    {"gamedig"},
+	"gamedig_given_port_only": {"gamedig"},
+	"packet_size":             {"ping"},
+	"push_token":              {"push"},
+}
+
+var _ resource.ConfigValidator = monitorTypeValidator{}
+
+// monitorTypeValidator checks the monitor's attributes against its type:
+// the type must be known, the attributes it needs must be set, and
+// attributes that belong to other types must not be.
+type monitorTypeValidator struct{}
+
+func (v monitorTypeValidator) Description(_ context.Context) string {
+	return "Checks that the attributes set match the monitor type."
+}
+
+func (v monitorTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v monitorTypeValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || monitorType.IsNull() || monitorType.IsUnknown() {
+		return
+	}
+
+	typ := monitorType.ValueString()
+	required, ok := monitorTypeRequired[typ]
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Monitor Type",
+			fmt.Sprintf("%q is not a monitor type supported by the provider. Supported types are: %s.", typ, strings.Join(monitorTypes(), ", ")),
+		)
+		return
+	}
+
+	var config monitorResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	set := configuredAttributes(&config)
+
+	for _, name := range required {
+		if !set[name] {
+			resp.Diagnostics.AddAttributeError(
+				attributePath(name),
+				"Missing Attribute Configuration",
+				fmt.Sprintf("Monitors of type %q require %s to be set.", typ, name),
+			)
+		}
+	}
+
+	for _, name := range sortedKeys(monitorTypeAttributes) {
+		applies := monitorTypeAttributes[name]
+		if !set[name] || slices.Contains(applies, typ) {
+			continue
+		}
+		resp.Diagnostics.AddAttributeError(
+			attributePath(name),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s does not apply to monitors of type %q; it is only used by: %s.", name, typ, strings.Join(applies, ", ")),
+		)
+	}
+}
+
+// monitorTypes returns the supported monitor types in order.
+func monitorTypes() []string {
+	return sortedKeys(monitorTypeRequired)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+// configuredAttributes returns the paths of the attributes set in m, and
+// the names of the blocks it configures.
+func configuredAttributes(m *monitorResourceModel) map[string]bool {
+	out := map[string]bool{}
+
+	for _, f := range mappedFields(m) {
+		if val, ok := f.value.Interface().(attr.Value); ok && !val.IsNull() {
+			out[f.path.String()] = true
+		}
+	}
+
+	for _, b := range blockFields(m) {
+		if !b.value.IsNil() {
+			out[b.name] = true
+		}
+	}
+
+	return out
+}
+
+// attributePath turns a path string such as "http.url" into a path.Path.
+func attributePath(name string) path.Path {
+	parts := strings.Split(name, ".")
+
+	p := path.Root(parts[0])
+	for _, part := range parts[1:] {
+		p = p.AtName(part)
+	}
+
+	return p
+}
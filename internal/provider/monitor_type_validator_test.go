@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// monitorConfig builds the configuration of a monitor resource from m.
+// Sets m leaves at their zero value become null sets.
+func monitorConfig(t *testing.T, m monitorResourceModel) tfsdk.Config {
+	t.Helper()
+
+	for _, f := range mappedFields(&m) {
+		if s, ok := f.value.Interface().(types.Set); ok && s.ElementType(context.Background()) == nil {
+			f.value.Set(reflect.ValueOf(types.SetNull(f.elem)))
+		}
+	}
+
+	state := monitorSchemaState(t, &monitorResource{})
+	if diags := state.Set(context.Background(), &m); diags.HasError() {
+		t.Fatalf("building the configuration: %v", diags)
+	}
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+// diagnosticsAt returns "summary at path" for every error in diags.
+func diagnosticsAt(diags diag.Diagnostics) []string {
+	var out []string
+	for _, d := range diags.Errors() {
+		p := ""
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			p = withPath.Path().String()
+		}
+		out = append(out, d.Summary()+" at "+p)
+	}
+	slices.Sort(out)
+
+	return out
+}
+
+func TestMonitorTypeValidator(t *testing.T) {
+	str := types.StringValue
+
+	tests := map[string]struct {
+		config monitorResourceModel
+		want   []string
+	}{
+		"null type": {
+			config: monitorResourceModel{Type: types.StringNull()},
+		},
+		"unknown type value": {
+			config: monitorResourceModel{Type: types.StringUnknown()},
+		},
+		"unsupported type": {
+			config: monitorResourceModel{Type: str("carrier-pigeon")},
+			want:   []string{"Invalid Monitor Type at type"},
+		},
+
+		// Required attributes.
+		"http with url": {
+			config: monitorResourceModel{
+				Type: str("http"),
+				HTTP: &monitorHTTPModel{monitorHTTPSettingsModel: monitorHTTPSettingsModel{URL: str("https://example.com")}},
+			},
+		},
+		"http without url": {
+			config: monitorResourceModel{Type: str("http")},
+			want:   []string{"Missing Attribute Configuration at http.url"},
+		},
+		"http with empty block": {
+			config: monitorResourceModel{Type: str("http"), HTTP: &monitorHTTPModel{}},
+			want:   []string{"Missing Attribute Configuration at http.url"},
+		},
+		"keyword without keyword": {
+			config: monitorResourceModel{
+				Type: str("keyword"),
+				HTTP: &monitorHTTPModel{monitorHTTPSettingsModel: monitorHTTPSettingsModel{URL: str("https://example.com")}},
+			},
+			want: []string{"Missing Attribute Configuration at keyword"},
+		},
+		"json-query without json_path": {
+			config: monitorResourceModel{
+				Type: str("json-query"),
+				HTTP: &monitorHTTPModel{monitorHTTPSettingsModel: monitorHTTPSettingsModel{URL: str("https://example.com")}},
+			},
+			want: []string{"Missing Attribute Configuration at http.json_path"},
+		},
+		"port without hostname and port": {
+			config: monitorResourceModel{Type: str("port")},
+			want: []string{
+				"Missing Attribute Configuration at hostname",
+				"Missing Attribute Configuration at port",
+			},
+		},
+		"dns with hostname": {
+			config: monitorResourceModel{Type: str("dns"), Hostname: str("example.com")},
+		},
+		"push needs nothing": {
+			config: monitorResourceModel{Type: str("push")},
+		},
+		"radius complete": {
+			config: monitorResourceModel{
+				Type:     str("radius"),
+				Hostname: str("radius.internal"),
+				Radius: &monitorRadiusModel{
+					Username:         str("u"),
+					Password:         str("p"),
+					Secret:           str("s"),
+					CalledStationId:  str("a"),
+					CallingStationId: str("b"),
+				},
+			},
+		},
+		"radius without secret": {
+			config: monitorResourceModel{
+				Type:     str("radius"),
+				Hostname: str("radius.internal"),
+				Radius: &monitorRadiusModel{
+					Username:         str("u"),
+					Password:         str("p"),
+					CalledStationId:  str("a"),
+					CallingStationId: str("b"),
+				},
+			},
+			want: []string{"Missing Attribute Configuration at radius.secret"},
+		},
+
+		// Attributes that belong to other types.
+		"ping with http block": {
+			config: monitorResourceModel{
+				Type:     str("ping"),
+				Hostname: str("gateway"),
+				HTTP:     &monitorHTTPModel{monitorHTTPSettingsModel: monitorHTTPSettingsModel{URL: str("https://example.com")}},
+			},
+			want: []string{"Invalid Attribute Combination at http"},
+		},
+		"http with packet_size": {
+			config: monitorResourceModel{
+				Type:       str("http"),
+				PacketSize: types.Int64Value(56),
+				HTTP:       &monitorHTTPModel{monitorHTTPSettingsModel: monitorHTTPSettingsModel{URL: str("https://example.com")}},
+			},
+			want: []string{"Invalid Attribute Combination at packet_size"},
+		},
+		"http with json_path": {
+			config: monitorResourceModel{
+				Type: str("http"),
+				HTTP: &monitorHTTPModel{
+					monitorHTTPSettingsModel: monitorHTTPSettingsModel{URL: str("https://example.com")},
+					JsonPath:                 str("$.status"),
+				},
+			},
+			want: []string{"Invalid Attribute Combination at http.json_path"},
+		},
+		"redis with ignore_tls": {
+			config: monitorResourceModel{
+				Type:     str("redis"),
+				Database: &monitorDatabaseModel{ConnectionString: str("redis://cache"), IgnoreTls: types.BoolValue(true)},
+			},
+		},
+		"postgres with ignore_tls": {
+			config: monitorResourceModel{
+				Type:     str("postgres"),
+				Database: &monitorDatabaseModel{ConnectionString: str("postgres://db"), IgnoreTls: types.BoolValue(true)},
+			},
+			want: []string{"Invalid Attribute Combination at database.ignore_tls"},
+		},
+		"missing and misplaced together": {
+			config: monitorResourceModel{
+				Type:      str("dns"),
+				PushToken: str("abc"),
+			},
+			want: []string{
+				"Invalid Attribute Combination at push_token",
+				"Missing Attribute Configuration at hostname",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: monitorConfig(t, tt.config)}
+			var resp resource.ValidateConfigResponse
+			monitorTypeValidator{}.ValidateResource(context.Background(), req, &resp)
+
+			if got := diagnosticsAt(resp.Diagnostics); !slices.Equal(got, tt.want) {
+				t.Errorf("errors = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+// Every supported type must accept its own required attributes, so a valid
+// configuration exists for each.
+func TestMonitorTypeRequiredAttributesApply(t *testing.T) {
+	for _, typ := range monitorTypes() {
+		for _, name := range monitorTypeRequired[typ] {
+			for _, prefix := range []string{name, blockOf(name)} {
+				if applies, ok := monitorTypeAttributes[prefix]; ok && !slices.Contains(applies, typ) {
+					t.Errorf("%s requires %s, which does not apply to it", typ, name)
+				}
+			}
+		}
+	}
+}
+
+// blockOf returns the block of a path such as "http.url", or the path itself.
+func blockOf(name string) string {
+	block, _, _ := strings.Cut(name, ".")
+	return block
+}
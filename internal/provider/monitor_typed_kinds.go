@@ -0,0 +1,541 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// typedMonitorKinds are the typed monitor resources, in the order the
+// provider registers them. Defaults follow the Uptime Kuma web UI.
+var typedMonitorKinds = []typedMonitorKind{
+	{
+		name:        "http_monitor",
+		description: "Manages an HTTP(s) monitor.",
+		accepts:     []string{"http"},
+		attributes:  httpMonitorAttributes(),
+		newModel:    func() typedMonitorModel { return &httpMonitorModel{} },
+	},
+	{
+		name:        "keyword_monitor",
+		description: "Manages an HTTP(s) monitor that looks for a keyword in the response.",
+		accepts:     []string{"keyword"},
+		attributes: mergeAttributes(httpMonitorAttributes(), map[string]schema.Attribute{
+			"keyword": schema.StringAttribute{
+				Required: true,
+			},
+			"invert_keyword": schema.BoolAttribute{
+				Description: "Fail when the keyword is present instead of when it is missing.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+		}),
+		newModel: func() typedMonitorModel { return &keywordMonitorModel{} },
+	},
+	{
+		name:        "json_query_monitor",
+		description: "Manages an HTTP(s) monitor that evaluates a JSON query against the response.",
+		accepts:     []string{"json-query"},
+		attributes: mergeAttributes(httpMonitorAttributes(), map[string]schema.Attribute{
+			"json_path": schema.StringAttribute{
+				Required: true,
+			},
+		}),
+		newModel: func() typedMonitorModel { return &jsonQueryMonitorModel{} },
+	},
+	{
+		name:        "dns_monitor",
+		description: "Manages a DNS monitor.",
+		accepts:     []string{"dns"},
+		attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(53),
+			},
+			"resolve_server": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("1.1.1.1"),
+			},
+			"resolve_type": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString("A"),
+			},
+		},
+		newModel: func() typedMonitorModel { return &dnsMonitorModel{} },
+	},
+	{
+		name:        "ping_monitor",
+		description: "Manages a ping monitor.",
+		accepts:     []string{"ping"},
+		attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"packet_size": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(56),
+			},
+		},
+		newModel: func() typedMonitorModel { return &pingMonitorModel{} },
+	},
+	{
+		name:        "port_monitor",
+		description: "Manages a TCP port monitor.",
+		accepts:     []string{"port"},
+		attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Required: true,
+			},
+		},
+		newModel: func() typedMonitorModel { return &portMonitorModel{} },
+	},
+	{
+		name:        "docker_monitor",
+		description: "Manages a monitor of a Docker container.",
+		accepts:     []string{"docker"},
+		attributes: map[string]schema.Attribute{
+			"container": schema.StringAttribute{
+				Description: "Name or ID of the container.",
+				Required:    true,
+			},
+			"docker_host": schema.Int64Attribute{
+				Description: "ID of the Docker host configured in Uptime Kuma.",
+				Required:    true,
+			},
+		},
+		newModel: func() typedMonitorModel { return &dockerMonitorModel{} },
+	},
+	{
+		name:        "push_monitor",
+		description: "Manages a push monitor, which expects to be called by the monitored service.",
+		accepts:     []string{"push"},
+		attributes: map[string]schema.Attribute{
+			"push_token": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		newModel: func() typedMonitorModel { return &pushMonitorModel{} },
+	},
+	{
+		name:        "mqtt_monitor",
+		description: "Manages an MQTT monitor.",
+		accepts:     []string{"mqtt"},
+		attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1883),
+			},
+			"username": schema.StringAttribute{
+				Optional: true,
+			},
+			"password": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+			},
+			"topic": schema.StringAttribute{
+				Required: true,
+			},
+			"success_message": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		newModel: func() typedMonitorModel { return &mqttMonitorModel{} },
+	},
+	{
+		name:        "grpc_keyword_monitor",
+		description: "Manages a gRPC monitor that looks for a keyword in the response.",
+		accepts:     []string{"grpc-keyword"},
+		attributes: map[string]schema.Attribute{
+			"url": schema.StringAttribute{
+				Required: true,
+			},
+			"service_name": schema.StringAttribute{
+				Optional: true,
+			},
+			"method": schema.StringAttribute{
+				Optional: true,
+			},
+			"body": schema.StringAttribute{
+				Optional: true,
+			},
+			"metadata": schema.StringAttribute{
+				Optional: true,
+			},
+			"protobuf": schema.StringAttribute{
+				Optional: true,
+			},
+			"enable_tls": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"keyword": schema.StringAttribute{
+				Required: true,
+			},
+			"invert_keyword": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		newModel: func() typedMonitorModel { return &grpcKeywordMonitorModel{} },
+	},
+	{
+		name:        "kafka_producer_monitor",
+		description: "Manages a monitor that produces a message to a Kafka topic.",
+		accepts:     []string{"kafka-producer"},
+		attributes: map[string]schema.Attribute{
+			"brokers": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"topic": schema.StringAttribute{
+				Required: true,
+			},
+			"message": schema.StringAttribute{
+				Required: true,
+			},
+			"ssl": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"sasl_options": schema.StringAttribute{
+				Optional: true,
+			},
+			"allow_auto_topic_creation": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+		},
+		newModel: func() typedMonitorModel { return &kafkaProducerMonitorModel{} },
+	},
+	{
+		name:        "database_monitor",
+		description: "Manages a database monitor.",
+		accepts:     databaseMonitorTypes,
+		attributes: map[string]schema.Attribute{
+			"type": schema.StringAttribute{
+				Description: "One of sqlserver, postgres, mysql, mongodb or redis.",
+				Required:    true,
+			},
+			"connection_string": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"query": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+		newModel: func() typedMonitorModel { return &databaseMonitorModel{} },
+	},
+	{
+		name:        "radius_monitor",
+		description: "Manages a RADIUS monitor.",
+		accepts:     []string{"radius"},
+		attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(1812),
+			},
+			"username": schema.StringAttribute{
+				Required: true,
+			},
+			"password": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"secret": schema.StringAttribute{
+				Required:  true,
+				Sensitive: true,
+			},
+			"called_station_id": schema.StringAttribute{
+				Required: true,
+			},
+			"calling_station_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		newModel: func() typedMonitorModel { return &radiusMonitorModel{} },
+	},
+	{
+		name:        "gamedig_monitor",
+		description: "Manages a game server monitor using GameDig.",
+		accepts:     []string{"gamedig"},
+		attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Required: true,
+			},
+			"port": schema.Int64Attribute{
+				Required: true,
+			},
+			"game": schema.StringAttribute{
+				Description: "GameDig game ID, such as minecraft.",
+				Required:    true,
+			},
+			"given_port_only": schema.BoolAttribute{
+				Description: "Only query the given port instead of letting GameDig search for the query port.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+		},
+		newModel: func() typedMonitorModel { return &gamedigMonitorModel{} },
+	},
+	{
+		name:        "group_monitor",
+		description: "Manages a group monitor, which other monitors join through their parent attribute.",
+		accepts:     []string{"group"},
+		attributes:  map[string]schema.Attribute{},
+		newModel:    func() typedMonitorModel { return &groupMonitorModel{} },
+	},
+}
+
+type httpMonitorModel struct {
+	monitorCommonModel
+	monitorHTTPSettingsModel
+}
+
+type keywordMonitorModel struct {
+	httpMonitorModel
+	Keyword       types.String `tfsdk:"keyword" kuma:"keyword"`
+	InvertKeyword types.Bool   `tfsdk:"invert_keyword" kuma:"invert_keyword"`
+}
+
+type jsonQueryMonitorModel struct {
+	httpMonitorModel
+	JsonPath types.String `tfsdk:"json_path" kuma:"json_path"`
+}
+
+type dnsMonitorModel struct {
+	monitorCommonModel
+	Hostname      types.String `tfsdk:"hostname" kuma:"hostname"`
+	Port          types.Int64  `tfsdk:"port" kuma:"port"`
+	ResolveServer types.String `tfsdk:"resolve_server" kuma:"dns_resolve_server"`
+	ResolveType   types.String `tfsdk:"resolve_type" kuma:"dns_resolve_type"`
+}
+
+type pingMonitorModel struct {
+	monitorCommonModel
+	Hostname   types.String `tfsdk:"hostname" kuma:"hostname"`
+	PacketSize types.Int64  `tfsdk:"packet_size" kuma:"packet_size"`
+}
+
+type portMonitorModel struct {
+	monitorCommonModel
+	Hostname types.String `tfsdk:"hostname" kuma:"hostname"`
+	Port     types.Int64  `tfsdk:"port" kuma:"port"`
+}
+
+type dockerMonitorModel struct {
+	monitorCommonModel
+	Container  types.String `tfsdk:"container" kuma:"docker_container"`
+	DockerHost types.Int64  `tfsdk:"docker_host" kuma:"docker_host"`
+}
+
+type pushMonitorModel struct {
+	monitorCommonModel
+	PushToken types.String `tfsdk:"push_token" kuma:"push_token"`
+}
+
+type mqttMonitorModel struct {
+	monitorCommonModel
+	Hostname       types.String `tfsdk:"hostname" kuma:"hostname"`
+	Port           types.Int64  `tfsdk:"port" kuma:"port"`
+	Username       types.String `tfsdk:"username" kuma:"mqtt_username"`
+	Password       types.String `tfsdk:"password" kuma:"mqtt_password"`
+	Topic          types.String `tfsdk:"topic" kuma:"mqtt_topic"`
+	SuccessMessage types.String `tfsdk:"success_message" kuma:"mqtt_success_message"`
+}
+
+type grpcKeywordMonitorModel struct {
+	monitorCommonModel
+	URL           types.String `tfsdk:"url" kuma:"grpc_url"`
+	ServiceName   types.String `tfsdk:"service_name" kuma:"grpc_service_name"`
+	Method        types.String `tfsdk:"method" kuma:"grpc_method"`
+	Body          types.String `tfsdk:"body" kuma:"grpc_body"`
+	Metadata      types.String `tfsdk:"metadata" kuma:"grpc_metadata"`
+	Protobuf      types.String `tfsdk:"protobuf" kuma:"grpc_protobuf"`
+	EnableTls     types.Bool   `tfsdk:"enable_tls" kuma:"grpc_enable_tls"`
+	Keyword       types.String `tfsdk:"keyword" kuma:"keyword"`
+	InvertKeyword types.Bool   `tfsdk:"invert_keyword" kuma:"invert_keyword"`
+}
+
+type kafkaProducerMonitorModel struct {
+	monitorCommonModel
+	Brokers                types.Set    `tfsdk:"brokers" kuma:"kafka_producer_brokers"`
+	Topic                  types.String `tfsdk:"topic" kuma:"kafka_producer_topic"`
+	Message                types.String `tfsdk:"message" kuma:"kafka_producer_message"`
+	Ssl                    types.Bool   `tfsdk:"ssl" kuma:"kafka_producer_ssl"`
+	SaslOptions            types.String `tfsdk:"sasl_options" kuma:"kafka_producer_sasl_options"`
+	AllowAutoTopicCreation types.Bool   `tfsdk:"allow_auto_topic_creation" kuma:"kafka_producer_allow_auto_topic_creation"`
+}
+
+type databaseMonitorModel struct {
+	monitorCommonModel
+	Type             types.String `tfsdk:"type" kuma:"type"`
+	ConnectionString types.String `tfsdk:"connection_string" kuma:"database_connection_string"`
+	Query            types.String `tfsdk:"query" kuma:"database_query"`
+}
+
+type radiusMonitorModel struct {
+	monitorCommonModel
+	Hostname         types.String `tfsdk:"hostname" kuma:"hostname"`
+	Port             types.Int64  `tfsdk:"port" kuma:"port"`
+	Username         types.String `tfsdk:"username" kuma:"radius_username"`
+	Password         types.String `tfsdk:"password" kuma:"radius_password"`
+	Secret           types.String `tfsdk:"secret" kuma:"radius_secret"`
+	CalledStationId  types.String `tfsdk:"called_station_id" kuma:"radius_called_station_id"`
+	CallingStationId types.String `tfsdk:"calling_station_id" kuma:"radius_calling_station_id"`
+}
+
+type gamedigMonitorModel struct {
+	monitorCommonModel
+	Hostname      types.String `tfsdk:"hostname" kuma:"hostname"`
+	Port          types.Int64  `tfsdk:"port" kuma:"port"`
+	Game          types.String `tfsdk:"game" kuma:"game"`
+	GivenPortOnly types.Bool   `tfsdk:"given_port_only" kuma:"gamedig_given_port_only"`
+}
+
+type groupMonitorModel struct {
+	monitorCommonModel
+}
+
+// httpMonitorAttributes returns the schema of httpMonitorModel beyond the
+// common attributes.
+func httpMonitorAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"url": schema.StringAttribute{
+			Required: true,
+		},
+		"method": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString("GET"),
+		},
+		"body": schema.StringAttribute{
+			Optional: true,
+		},
+		"body_encoding": schema.StringAttribute{
+			Description: "Encoding of body, json or xml.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString("json"),
+		},
+		"headers": schema.StringAttribute{
+			Description: "Request headers as a JSON object.",
+			Optional:    true,
+		},
+		"max_redirects": schema.Int64Attribute{
+			Optional: true,
+			Computed: true,
+			Default:  int64default.StaticInt64(10),
+		},
+		"accepted_statuscodes": schema.SetAttribute{
+			Description: "Status codes or ranges, such as 200-299, counted as up.",
+			ElementType: types.StringType,
+			Optional:    true,
+			Computed:    true,
+			Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("200-299"),
+			})),
+			Validators: []validator.Set{
+				statusCodesValidator{},
+			},
+		},
+		"ignore_tls": schema.BoolAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  booldefault.StaticBool(false),
+		},
+		"expiry_notification": schema.BoolAttribute{
+			Description: "Notify when the TLS certificate is about to expire.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"proxy_id": schema.Int64Attribute{
+			Optional: true,
+		},
+		"auth_method": schema.StringAttribute{
+			Description: "One of basic, ntlm, mtls or oauth2-cc; unset for no authentication.",
+			Optional:    true,
+		},
+		"basic_auth_user": schema.StringAttribute{
+			Optional: true,
+		},
+		"basic_auth_pass": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"auth_domain": schema.StringAttribute{
+			Optional: true,
+		},
+		"auth_workstation": schema.StringAttribute{
+			Optional: true,
+		},
+		"oauth_auth_method": schema.StringAttribute{
+			Optional: true,
+			Computed: true,
+			Default:  stringdefault.StaticString("client_secret_basic"),
+		},
+		"oauth_client_id": schema.StringAttribute{
+			Optional: true,
+		},
+		"oauth_client_secret": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+		"oauth_scopes": schema.SetAttribute{
+			ElementType: types.StringType,
+			Optional:    true,
+		},
+		"oauth_token_url": schema.StringAttribute{
+			Optional: true,
+		},
+		"tls_ca": schema.StringAttribute{
+			Optional: true,
+		},
+		"tls_cert": schema.StringAttribute{
+			Optional: true,
+		},
+		"tls_key": schema.StringAttribute{
+			Optional:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+// mergeAttributes returns base with extra added.
+func mergeAttributes(base, extra map[string]schema.Attribute) map[string]schema.Attribute {
+	for name, attribute := range extra {
+		base[name] = attribute
+	}
+
+	return base
+}
@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &typedMonitorResource{}
+	_ resource.ResourceWithConfigure      = &typedMonitorResource{}
+	_ resource.ResourceWithImportState    = &typedMonitorResource{}
+	_ resource.ResourceWithValidateConfig = &typedMonitorResource{}
+)
+
+// typedMonitorKind describes one of the resources that manage a single kind
+// of monitor, such as uptime-kuma_http_monitor. They all share
+// typedMonitorResource and the mapping in monitor_mapping.go; a kind only
+// brings its model and the attributes on top of monitorCommonAttributes.
+type typedMonitorKind struct {
+	// name is the resource type name without the provider prefix.
+	name        string
+	description string
+
+	// accepts lists the Uptime Kuma monitor types the resource manages. With
+	// a single entry the type is implied; otherwise the model has a type
+	// attribute that must be one of them.
+	accepts []string
+
+	attributes map[string]schema.Attribute
+	newModel   func() typedMonitorModel
+}
+
+// typedMonitorModel is implemented by the models of the typed monitor
+// resources through the embedded monitorCommonModel.
+type typedMonitorModel interface {
+	common() *monitorCommonModel
+}
+
+// monitorCommonModel holds the attributes every typed monitor resource has.
+type monitorCommonModel struct {
+	ID                 types.Int64            `tfsdk:"id" kuma:"id"`
+	Name               types.String           `tfsdk:"name" kuma:"name"`
+	Interval           types.Int64            `tfsdk:"interval" kuma:"interval"`
+	RetryInterval      types.Int64            `tfsdk:"retry_interval" kuma:"retry_interval"`
+	ResendInterval     types.Int64            `tfsdk:"resend_interval" kuma:"resend_interval"`
+	MaxRetries         types.Int64            `tfsdk:"max_retries" kuma:"max_retries"`
+	UpsideDown         types.Bool             `tfsdk:"upside_down" kuma:"upside_down"`
+	Active             types.Bool             `tfsdk:"active" kuma:"active"`
+	Parent             types.String           `tfsdk:"parent" kuma:"parent"`
+	Timeout            types.Int64            `tfsdk:"timeout" kuma:"timeout"`
+	Weight             types.Int64            `tfsdk:"weight" kuma:"weight"`
+	NotificationIDList types.Set              `tfsdk:"notification_id_list" kuma:"notification_id_list,int64"`
+	Tags               []tagInstanceDataModel `tfsdk:"tags" kuma:"-"`
+}
+
+func (m *monitorCommonModel) common() *monitorCommonModel {
+	return m
+}
+
+// monitorCommonAttributes returns the schema of monitorCommonModel.
+func monitorCommonAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"interval": schema.Int64Attribute{
+			Description: "Seconds between checks.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(60),
+		},
+		"retry_interval": schema.Int64Attribute{
+			Description: "Seconds between checks while the monitor is failing.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(60),
+		},
+		"resend_interval": schema.Int64Attribute{
+			Description: "Resend notifications every this many failed checks; 0 disables resending.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+		"max_retries": schema.Int64Attribute{
+			Description: "Failed checks tolerated before the monitor is marked down.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+		"upside_down": schema.BoolAttribute{
+			Description: "Treat a successful check as down and a failed one as up.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether the monitor is running.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(true),
+		},
+		"parent": schema.StringAttribute{
+			Description: "ID of the group monitor this monitor belongs to.",
+			Optional:    true,
+		},
+		"timeout": schema.Int64Attribute{
+			Description: "Seconds to wait for a check to complete.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(48),
+		},
+		"weight": schema.Int64Attribute{
+			Description: "Sort weight of the monitor in the Uptime Kuma UI.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(2000),
+		},
+		"notification_id_list": schema.SetAttribute{
+			ElementType: types.Int64Type,
+			Optional:    true,
+		},
+		"tags": monitorTagsAttribute(),
+	}
+}
+
+// typedMonitorResources returns the constructors of the typed monitor
+// resources, for the provider's Resources.
+func typedMonitorResources() []func() resource.Resource {
+	var out []func() resource.Resource
+	for _, kind := range typedMonitorKinds {
+		out = append(out, func() resource.Resource {
+			return &typedMonitorResource{kind: kind}
+		})
+	}
+
+	return out
+}
+
+// typedMonitorResource is the resource implementation shared by every
+// typedMonitorKind.
+type typedMonitorResource struct {
+	client *kumaclient.Client
+	kind   typedMonitorKind
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *typedMonitorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*kumaclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *typedMonitorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + r.kind.name
+}
+
+// Schema defines the schema for the resource.
+func (r *typedMonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: r.kind.description,
+		Attributes:  mergeAttributes(monitorCommonAttributes(), r.kind.attributes),
+	}
+}
+
+// ValidateConfig checks the monitor type of resources that manage more than
+// one.
+func (r *typedMonitorResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if len(r.kind.accepts) == 1 {
+		return
+	}
+
+	var monitorType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("type"), &monitorType)...)
+	if resp.Diagnostics.HasError() || monitorType.IsNull() || monitorType.IsUnknown() {
+		return
+	}
+
+	if !slices.Contains(r.kind.accepts, monitorType.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("type"),
+			"Invalid Monitor Type",
+			fmt.Sprintf("%q is not supported by this resource. Supported types are: %s.", monitorType.ValueString(), strings.Join(r.kind.accepts, ", ")),
+		)
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *typedMonitorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withRedaction(ctx)
+
+	plan := r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	makeMon, diags := r.monitorFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating monitor", map[string]any{"monitor": kumaclient.RedactedJSON(makeMon)})
+
+	newMon, err := r.client.CreateMonitor(ctx, &makeMon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMonitorModel(ctx, plan, newMon, computedAttributes(ctx, resp.State.Schema))...)
+	plan.common().Tags = monitorTags(newMon)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *typedMonitorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withRedaction(ctx)
+
+	state := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.common().ID.ValueInt64()
+
+	tflog.Debug(ctx, fmt.Sprintf("Requesting monitor %d", id))
+	monitor, err := r.client.GetMonitor(ctx, id)
+	if kumaclient.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Monitor %d no longer exists, removing it from state", id))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	if !slices.Contains(r.kind.accepts, monitor.Type) {
+		resp.Diagnostics.AddError(
+			"Unexpected Monitor Type",
+			fmt.Sprintf("Monitor %d is a %q monitor, but this resource only manages %s monitors. Use the matching typed resource or uptime-kuma_monitor instead.",
+				id, monitor.Type, strings.Join(r.kind.accepts, ", ")),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMonitorModel(ctx, state, monitor, computedAttributes(ctx, resp.State.Schema))...)
+	state.common().Tags = monitorTags(monitor)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *typedMonitorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withRedaction(ctx)
+
+	plan, state := r.kind.newModel(), r.kind.newModel()
+	resp.Diagnostics.Append(req.Plan.Get(ctx, plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.common().ID
+	plan.common().ID = id
+
+	editMon, diags := r.monitorFromModel(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating monitor", map[string]any{"monitor": kumaclient.RedactedJSON(editMon)})
+
+	updatedMon, err := r.client.UpdateMonitor(ctx, id.ValueInt64(), &editMon)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setMonitorModel(ctx, plan, updatedMon, computedAttributes(ctx, resp.State.Schema))...)
+	plan.common().Tags = monitorTags(updatedMon)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *typedMonitorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withRedaction(ctx)
+
+	state := r.kind.newModel()
+	resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.common().ID.ValueInt64()
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting monitor %d", id))
+	err := r.client.DeleteMonitor(ctx, id)
+	if kumaclient.IsNotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting monitor (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState adopts an existing monitor by its numeric ID. Read checks that
+// the monitor has a type this resource manages.
+func (r *typedMonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the numeric ID of an Uptime Kuma monitor, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// monitorFromModel maps model onto the API, filling in the implied monitor
+// type.
+func (r *typedMonitorResource) monitorFromModel(ctx context.Context, model typedMonitorModel) (kumaclient.Monitor, diag.Diagnostics) {
+	mon, diags := monitorFromModel(ctx, model)
+	if len(r.kind.accepts) == 1 {
+		mon.Type = r.kind.accepts[0]
+	}
+
+	return mon, diags
+}
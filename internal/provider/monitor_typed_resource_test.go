@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Every typed monitor must send timeout and weight, or Uptime Kuma stores 0
+// for them.
+func TestTypedMonitorTimeoutAndWeight(t *testing.T) {
+	ctx := context.Background()
+
+	for _, kind := range typedMonitorKinds {
+		t.Run(kind.name, func(t *testing.T) {
+			r := &typedMonitorResource{kind: kind}
+			var resp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+			for name, want := range map[string]int64{"timeout": 48, "weight": 2000} {
+				a, ok := resp.Schema.Attributes[name].(schema.Int64Attribute)
+				if !ok || a.Default == nil {
+					t.Errorf("%s has no defaulted %s attribute", kind.name, name)
+					continue
+				}
+				var d defaults.Int64Response
+				a.Default.DefaultInt64(ctx, defaults.Int64Request{}, &d)
+				if got := d.PlanValue.ValueInt64(); got != want {
+					t.Errorf("%s defaults %s to %d, want %d", kind.name, name, got, want)
+				}
+			}
+
+			model := kind.newModel()
+			model.common().Name = types.StringValue("m")
+			model.common().Timeout = types.Int64Value(48)
+			model.common().Weight = types.Int64Value(2000)
+
+			mon, diags := monitorFromModel(ctx, model)
+			if diags.HasError() {
+				t.Fatalf("monitorFromModel: %v", diags)
+			}
+			if mon.Timeout == nil || *mon.Timeout != 48 || mon.Weight == nil || *mon.Weight != 2000 {
+				t.Errorf("sent timeout %v and weight %v, want 48 and 2000", mon.Timeout, mon.Weight)
+			}
+		})
+	}
+}
@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &notificationResource{}
+	_ resource.ResourceWithConfigure        = &notificationResource{}
+	_ resource.ResourceWithImportState      = &notificationResource{}
+	_ resource.ResourceWithConfigValidators = &notificationResource{}
+	_ resource.ResourceWithUpgradeState     = &notificationResource{}
+)
+
+// NewNotificationResource is a helper function to simplify the provider implementation.
+func NewNotificationResource() resource.Resource {
+	return &notificationResource{}
+}
+
+// notificationResource is the resource implementation.
+type notificationResource struct {
+	client *kumaclient.Client
+}
+
+// notificationResourceModel maps the resource schema data. Exactly one of
+// the blocks is set; it decides the notification type.
+type notificationResourceModel struct {
+	ID            types.Int64  `tfsdk:"id" kuma:"-"`
+	Name          types.String `tfsdk:"name" kuma:"-"`
+	Active        types.Bool   `tfsdk:"active" kuma:"-"`
+	IsDefault     types.Bool   `tfsdk:"is_default" kuma:"-"`
+	ApplyExisting types.Bool   `tfsdk:"apply_existing" kuma:"-"`
+
+	Slack      *notificationSlackModel      `tfsdk:"slack"`
+	Discord    *notificationDiscordModel    `tfsdk:"discord"`
+	Teams      *notificationTeamsModel      `tfsdk:"teams"`
+	Telegram   *notificationTelegramModel   `tfsdk:"telegram"`
+	SMTP       *notificationSMTPModel       `tfsdk:"smtp"`
+	Webhook    *notificationWebhookModel    `tfsdk:"webhook"`
+	PagerDuty  *notificationPagerDutyModel  `tfsdk:"pagerduty"`
+	Opsgenie   *notificationOpsgenieModel   `tfsdk:"opsgenie"`
+	Gotify     *notificationGotifyModel     `tfsdk:"gotify"`
+	Ntfy       *notificationNtfyModel       `tfsdk:"ntfy"`
+	Pushover   *notificationPushoverModel   `tfsdk:"pushover"`
+	Matrix     *notificationMatrixModel     `tfsdk:"matrix"`
+	Mattermost *notificationMattermostModel `tfsdk:"mattermost"`
+	RocketChat *notificationRocketChatModel `tfsdk:"rocket_chat"`
+	GoogleChat *notificationGoogleChatModel `tfsdk:"google_chat"`
+	Signal     *notificationSignalModel     `tfsdk:"signal"`
+	Pushbullet *notificationPushbulletModel `tfsdk:"pushbullet"`
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *notificationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*kumaclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *notificationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+// Schema defines the schema for the resource.
+func (r *notificationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.Int64{
+				int64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"name": schema.StringAttribute{
+			Required: true,
+		},
+		"active": schema.BoolAttribute{
+			Description: "Whether the notification is enabled. New notifications are enabled; if not configured, the value set in the Uptime Kuma UI is kept.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Bool{
+				boolplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"is_default": schema.BoolAttribute{
+			Description: "Enable this notification on new monitors by default.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"apply_existing": schema.BoolAttribute{
+			Description: "Add this notification to every existing monitor when it is saved.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+	}
+	for name, block := range notificationBlockAttributes() {
+		attributes[name] = block
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Manages a notification that monitors can reference in notification_id_list. Configure it with the one block named after its type, such as slack or smtp.",
+		Version:     1,
+		Attributes:  attributes,
+	}
+}
+
+// ConfigValidators checks that exactly one notification block is set.
+func (r *notificationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{notificationBlockValidator{}}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *notificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withRedaction(ctx)
+
+	var plan notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, diags := notificationFromModel(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating notification", map[string]any{"notification": kumaclient.RedactedJSON(notification)})
+
+	created, err := r.client.CreateNotification(ctx, &notification)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new notification (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setNotificationModel(&plan, created, computedAttributes(ctx, resp.State.Schema))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *notificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withRedaction(ctx)
+
+	var state notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Requesting notification %d", state.ID.ValueInt64()))
+	notification, err := r.client.GetNotification(ctx, state.ID.ValueInt64())
+	if kumaclient.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Notification %d no longer exists, removing it from state", state.ID.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading notification (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setNotificationModel(&state, notification, computedAttributes(ctx, resp.State.Schema))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *notificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withRedaction(ctx)
+
+	var plan, state notificationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notification, diags := notificationFromModel(&plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	notification.ID = state.ID.ValueInt64()
+
+	tflog.Debug(ctx, "Updating notification", map[string]any{"notification": kumaclient.RedactedJSON(notification)})
+
+	updated, err := r.client.UpdateNotification(ctx, state.ID.ValueInt64(), &notification)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating notification (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(setNotificationModel(&plan, updated, computedAttributes(ctx, resp.State.Schema))...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *notificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withRedaction(ctx)
+
+	var state notificationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting notification %d", state.ID.ValueInt64()))
+	err := r.client.DeleteNotification(ctx, state.ID.ValueInt64())
+	if kumaclient.IsNotFound(err) {
+		// Already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting notification (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState adopts an existing notification by its numeric ID; Read fills
+// in the rest of the attributes.
+func (r *notificationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the numeric ID of an Uptime Kuma notification, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// UpgradeState moves state written with the generic config and secrets maps
+// into the block of the notification's type.
+func (r *notificationResource) UpgradeState(_ context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{
+		0: {
+			StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+				if req.RawState == nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Notification State", "The prior state is empty.")
+					return
+				}
+
+				var prior struct {
+					ID            int64             `json:"id"`
+					Name          string            `json:"name"`
+					Type          string            `json:"type"`
+					IsDefault     bool              `json:"is_default"`
+					ApplyExisting bool              `json:"apply_existing"`
+					Config        map[string]string `json:"config"`
+					Secrets       map[string]string `json:"secrets"`
+				}
+				if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+					resp.Diagnostics.AddError("Unable to Upgrade Notification State", "Decoding the prior state: "+err.Error())
+					return
+				}
+
+				notification := kumaclient.Notification{
+					ID:            prior.ID,
+					Name:          prior.Name,
+					Type:          prior.Type,
+					Active:        true,
+					IsDefault:     prior.IsDefault,
+					ApplyExisting: prior.ApplyExisting,
+					Config:        map[string]any{},
+				}
+				for _, settings := range []map[string]string{prior.Config, prior.Secrets} {
+					for k, v := range settings {
+						notification.Config[k] = v
+					}
+				}
+
+				var state notificationResourceModel
+				resp.Diagnostics.Append(setNotificationModel(&state, &notification, computedAttributes(ctx, resp.State.Schema))...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+			},
+		},
+	}
+}
+
+// notificationFromModel builds the API notification from m. The type comes
+// from the block that is set, and null settings are left out.
+func notificationFromModel(m *notificationResourceModel) (kumaclient.Notification, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	n := kumaclient.Notification{
+		Name:          m.Name.ValueString(),
+		Active:        m.Active.ValueBool(),
+		IsDefault:     m.IsDefault.ValueBool(),
+		ApplyExisting: m.ApplyExisting.ValueBool(),
+		Config:        map[string]any{},
+	}
+	if m.Active.IsNull() || m.Active.IsUnknown() {
+		// Only a new notification has no active value; enable it.
+		n.Active = true
+	}
+	for _, b := range blockFields(m) {
+		if !b.value.IsNil() {
+			n.Type = notificationBlockTypes[b.name]
+		}
+	}
+
+	for _, f := range mappedFields(m) {
+		value, ok := f.value.Interface().(attr.Value)
+		if ok && (value.IsNull() || value.IsUnknown()) {
+			continue
+		}
+
+		switch v := value.(type) {
+		case types.String:
+			n.Config[f.kuma] = v.ValueString()
+		case types.Int64:
+			n.Config[f.kuma] = v.ValueInt64()
+		case types.Bool:
+			n.Config[f.kuma] = v.ValueBool()
+		default:
+			diags.AddAttributeError(f.path, "Unsupported Notification Attribute Type",
+				fmt.Sprintf("The provider cannot map %T onto the API. Please report this issue to the provider developers.", f.value.Interface()))
+		}
+	}
+
+	return n, diags
+}
+
+// setNotificationModel copies n into m, filling in the block of its type and
+// clearing the others. Settings that are null in m and empty in n stay null
+// unless computed reports them as Computed, as for monitors.
+func setNotificationModel(m *notificationResourceModel, n *kumaclient.Notification, computed func(path.Path) bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	block, ok := notificationBlock(n.Type)
+	if !ok {
+		diags.AddError(
+			"Unsupported Notification Type",
+			fmt.Sprintf("Notification %d has type %q, which has no block in this provider. Supported types are: %s.",
+				n.ID, n.Type, strings.Join(sortedKeys(notificationBlockTypes), ", ")),
+		)
+		return diags
+	}
+
+	m.ID = types.Int64Value(n.ID)
+	m.Name = types.StringValue(n.Name)
+	m.Active = types.BoolValue(n.Active)
+	m.IsDefault = types.BoolValue(n.IsDefault)
+	m.ApplyExisting = types.BoolValue(n.ApplyExisting)
+
+	for _, b := range blockFields(m) {
+		switch {
+		case b.name != block:
+			b.value.Set(reflect.Zero(b.value.Type()))
+		case b.value.IsNil():
+			b.value.Set(reflect.New(b.value.Type().Elem()))
+		}
+	}
+
+	for _, f := range mappedFields(m) {
+		prior, _ := f.value.Interface().(attr.Value)
+		keepNull := prior != nil && prior.IsNull() && !computed(f.path)
+
+		raw := n.Config[f.kuma]
+		switch f.value.Interface().(type) {
+		case types.String:
+			f.value.Set(reflect.ValueOf(stringAttr(keepNull, notificationString(raw))))
+		case types.Int64:
+			f.value.Set(reflect.ValueOf(int64Attr(keepNull, notificationInt64(raw))))
+		case types.Bool:
+			f.value.Set(reflect.ValueOf(boolAttr(keepNull, notificationBool(raw))))
+		}
+	}
+
+	return diags
+}
+
+// Uptime Kuma stores whatever the form sent, so settings entered in its UI
+// can come back as strings where the provider sends numbers or booleans.
+// The helpers below accept either and return the zero value for anything
+// else, including a missing setting.
+
+func notificationString(v any) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+
+	return ""
+}
+
+func notificationInt64(v any) int64 {
+	switch v := v.(type) {
+	case float64:
+		return int64(v)
+	case string:
+		n, _ := strconv.ParseInt(v, 10, 64)
+		return n
+	}
+
+	return 0
+}
+
+func notificationBool(v any) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	}
+
+	return false
+}
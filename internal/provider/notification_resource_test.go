@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+func TestNotificationActive(t *testing.T) {
+	tests := map[string]struct {
+		active types.Bool
+		want   bool
+	}{
+		"unknown on create": {active: types.BoolUnknown(), want: true},
+		"null":              {active: types.BoolNull(), want: true},
+		"enabled":           {active: types.BoolValue(true), want: true},
+		"disabled":          {active: types.BoolValue(false), want: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			m := notificationResourceModel{
+				Name:   types.StringValue("ops"),
+				Active: tt.active,
+				Slack:  &notificationSlackModel{WebhookURL: types.StringValue("https://hooks.slack.com/x")},
+			}
+			n, diags := notificationFromModel(&m)
+			if diags.HasError() {
+				t.Fatalf("notificationFromModel: %v", diags)
+			}
+			if n.Active != tt.want {
+				t.Errorf("Active = %t, want %t", n.Active, tt.want)
+			}
+		})
+	}
+}
+
+// A notification disabled in the Uptime Kuma UI must read back as disabled,
+// so that an unconfigured active keeps it that way on the next apply.
+func TestNotificationReadKeepsDisabled(t *testing.T) {
+	var m notificationResourceModel
+	n := &kumaclient.Notification{
+		ID:     3,
+		Name:   "ops",
+		Type:   "slack",
+		Active: false,
+		Config: map[string]any{"slackwebhookURL": "https://hooks.slack.com/x"},
+	}
+	if diags := setNotificationModel(&m, n, func(path.Path) bool { return false }); diags.HasError() {
+		t.Fatalf("setNotificationModel: %v", diags)
+	}
+	if !m.Active.Equal(types.BoolValue(false)) {
+		t.Fatalf("active = %s, want false", m.Active)
+	}
+
+	sent, diags := notificationFromModel(&m)
+	if diags.HasError() {
+		t.Fatalf("notificationFromModel: %v", diags)
+	}
+	if sent.Active {
+		t.Error("the update re-enables the notification")
+	}
+}
+
+// State written with the generic type, config and secrets attributes moves
+// into the block of its type, secrets included.
+func TestNotificationUpgradeStateFromV0(t *testing.T) {
+	tests := map[string]struct {
+		prior   map[string]any
+		wantErr bool
+	}{
+		"slack": {
+			prior: map[string]any{
+				"id":             3,
+				"name":           "ops",
+				"type":           "slack",
+				"is_default":     true,
+				"apply_existing": false,
+				"config":         map[string]string{"slackchannel": "#ops"},
+				"secrets":        map[string]string{"slackwebhookURL": "https://hooks.slack.com/x"},
+			},
+		},
+		"without secrets": {
+			prior: map[string]any{
+				"id":     3,
+				"name":   "ops",
+				"type":   "slack",
+				"config": map[string]string{"slackchannel": "#ops", "slackwebhookURL": "https://hooks.slack.com/x"},
+			},
+		},
+		"unsupported type": {
+			prior: map[string]any{
+				"id":     3,
+				"name":   "ops",
+				"type":   "carrier-pigeon",
+				"config": map[string]string{},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			r := &notificationResource{}
+
+			raw, err := json.Marshal(tt.prior)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp := resource.UpgradeStateResponse{State: monitorSchemaState(t, r)}
+			r.UpgradeState(ctx)[0].StateUpgrader(ctx, resource.UpgradeStateRequest{RawState: &tfprotov6.RawState{JSON: raw}}, &resp)
+			if tt.wantErr {
+				if !resp.Diagnostics.HasError() {
+					t.Fatal("UpgradeState succeeded, want an error")
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("UpgradeState: %v", resp.Diagnostics)
+			}
+
+			var state notificationResourceModel
+			if diags := resp.State.Get(ctx, &state); diags.HasError() {
+				t.Fatalf("reading the state: %v", diags)
+			}
+			if state.ID.ValueInt64() != 3 || state.Name.ValueString() != "ops" || !state.Active.ValueBool() {
+				t.Errorf("id = %s, name = %s, active = %s; want 3, ops, true", state.ID, state.Name, state.Active)
+			}
+			if state.IsDefault.ValueBool() != (tt.prior["is_default"] == true) {
+				t.Errorf("is_default = %s, want %v", state.IsDefault, tt.prior["is_default"])
+			}
+			if state.Slack == nil {
+				t.Fatal("slack = nil, want the block of the notification's type")
+			}
+			if state.Slack.WebhookURL.ValueString() != "https://hooks.slack.com/x" || state.Slack.Channel.ValueString() != "#ops" {
+				t.Errorf("slack = %+v, want the webhook URL and channel from the prior state", *state.Slack)
+			}
+			if !state.Slack.Username.IsNull() {
+				t.Errorf("slack.username = %s, want null as the prior state had none", state.Slack.Username)
+			}
+			if state.Discord != nil || state.SMTP != nil {
+				t.Errorf("discord = %v, smtp = %v, want no other blocks", state.Discord, state.SMTP)
+			}
+		})
+	}
+}
+
+func TestNotificationReadRemovesMissing(t *testing.T) {
+	_, client := newFakeBridge(t, nil)
+	readMissing(t, &notificationResource{client: client}, int64(3))
+}
@@ -0,0 +1,556 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Each notification type has its own block, whose model fields carry a kuma
+// tag naming the key Uptime Kuma uses for the setting in its notification
+// JSON. The blocks are mapped with the same mappedFields and blockFields
+// helpers as monitors.
+
+// notificationBlockTypes maps each block to the Uptime Kuma notification type
+// it configures. Its keys are the blocks of notificationResourceModel.
+var notificationBlockTypes = map[string]string{
+	"slack":       "slack",
+	"discord":     "discord",
+	"teams":       "teams",
+	"telegram":    "telegram",
+	"smtp":        "smtp",
+	"webhook":     "webhook",
+	"pagerduty":   "PagerDuty",
+	"opsgenie":    "Opsgenie",
+	"gotify":      "gotify",
+	"ntfy":        "ntfy",
+	"pushover":    "pushover",
+	"matrix":      "matrix",
+	"mattermost":  "mattermost",
+	"rocket_chat": "rocket.chat",
+	"google_chat": "GoogleChat",
+	"signal":      "signal",
+	"pushbullet":  "pushbullet",
+}
+
+// notificationBlock returns the block that configures notifications of the
+// given Uptime Kuma type.
+func notificationBlock(notificationType string) (string, bool) {
+	for block, typ := range notificationBlockTypes {
+		if typ == notificationType {
+			return block, true
+		}
+	}
+
+	return "", false
+}
+
+type notificationSlackModel struct {
+	WebhookURL    types.String `tfsdk:"webhook_url" kuma:"slackwebhookURL"`
+	Channel       types.String `tfsdk:"channel" kuma:"slackchannel"`
+	Username      types.String `tfsdk:"username" kuma:"slackusername"`
+	IconEmoji     types.String `tfsdk:"icon_emoji" kuma:"slackiconemo"`
+	ChannelNotify types.Bool   `tfsdk:"channel_notify" kuma:"slackchannelnotify"`
+}
+
+type notificationDiscordModel struct {
+	WebhookURL    types.String `tfsdk:"webhook_url" kuma:"discordWebhookUrl"`
+	Username      types.String `tfsdk:"username" kuma:"discordUsername"`
+	PrefixMessage types.String `tfsdk:"prefix_message" kuma:"discordPrefixMessage"`
+}
+
+type notificationTeamsModel struct {
+	WebhookURL types.String `tfsdk:"webhook_url" kuma:"webhookUrl"`
+}
+
+type notificationTelegramModel struct {
+	BotToken        types.String `tfsdk:"bot_token" kuma:"telegramBotToken"`
+	ChatID          types.String `tfsdk:"chat_id" kuma:"telegramChatID"`
+	MessageThreadID types.String `tfsdk:"message_thread_id" kuma:"telegramMessageThreadID"`
+	SendSilently    types.Bool   `tfsdk:"send_silently" kuma:"telegramSendSilently"`
+	ProtectContent  types.Bool   `tfsdk:"protect_content" kuma:"telegramProtectContent"`
+}
+
+type notificationSMTPModel struct {
+	Host           types.String `tfsdk:"host" kuma:"smtpHost"`
+	Port           types.Int64  `tfsdk:"port" kuma:"smtpPort"`
+	Secure         types.Bool   `tfsdk:"secure" kuma:"smtpSecure"`
+	IgnoreTLSError types.Bool   `tfsdk:"ignore_tls_error" kuma:"smtpIgnoreTLSError"`
+	Username       types.String `tfsdk:"username" kuma:"smtpUsername"`
+	Password       types.String `tfsdk:"password" kuma:"smtpPassword"`
+	From           types.String `tfsdk:"from" kuma:"smtpFrom"`
+	To             types.String `tfsdk:"to" kuma:"smtpTo"`
+	CC             types.String `tfsdk:"cc" kuma:"smtpCC"`
+	BCC            types.String `tfsdk:"bcc" kuma:"smtpBCC"`
+	Subject        types.String `tfsdk:"subject" kuma:"customSubject"`
+	Body           types.String `tfsdk:"body" kuma:"customBody"`
+}
+
+type notificationWebhookModel struct {
+	URL               types.String `tfsdk:"url" kuma:"webhookURL"`
+	ContentType       types.String `tfsdk:"content_type" kuma:"webhookContentType"`
+	AdditionalHeaders types.String `tfsdk:"additional_headers" kuma:"webhookAdditionalHeaders"`
+	CustomBody        types.String `tfsdk:"custom_body" kuma:"webhookCustomBody"`
+}
+
+type notificationPagerDutyModel struct {
+	IntegrationKey types.String `tfsdk:"integration_key" kuma:"pagerdutyIntegrationKey"`
+	IntegrationURL types.String `tfsdk:"integration_url" kuma:"pagerdutyIntegrationUrl"`
+	Priority       types.String `tfsdk:"priority" kuma:"pagerdutyPriority"`
+	AutoResolve    types.String `tfsdk:"auto_resolve" kuma:"pagerdutyAutoResolve"`
+}
+
+type notificationOpsgenieModel struct {
+	APIKey   types.String `tfsdk:"api_key" kuma:"opsgenieApiKey"`
+	Region   types.String `tfsdk:"region" kuma:"opsgenieRegion"`
+	Priority types.Int64  `tfsdk:"priority" kuma:"opsgeniePriority"`
+}
+
+type notificationGotifyModel struct {
+	ServerURL        types.String `tfsdk:"server_url" kuma:"gotifyserverurl"`
+	ApplicationToken types.String `tfsdk:"application_token" kuma:"gotifyapplicationToken"`
+	Priority         types.Int64  `tfsdk:"priority" kuma:"gotifyPriority"`
+}
+
+type notificationNtfyModel struct {
+	ServerURL            types.String `tfsdk:"server_url" kuma:"ntfyserverurl"`
+	Topic                types.String `tfsdk:"topic" kuma:"ntfytopic"`
+	Priority             types.Int64  `tfsdk:"priority" kuma:"ntfyPriority"`
+	AuthenticationMethod types.String `tfsdk:"authentication_method" kuma:"ntfyAuthenticationMethod"`
+	Username             types.String `tfsdk:"username" kuma:"ntfyusername"`
+	Password             types.String `tfsdk:"password" kuma:"ntfypassword"`
+	AccessToken          types.String `tfsdk:"access_token" kuma:"ntfyaccesstoken"`
+	Icon                 types.String `tfsdk:"icon" kuma:"ntfyIcon"`
+}
+
+type notificationPushoverModel struct {
+	UserKey  types.String `tfsdk:"user_key" kuma:"pushoveruserkey"`
+	AppToken types.String `tfsdk:"app_token" kuma:"pushoverapptoken"`
+	Sounds   types.String `tfsdk:"sounds" kuma:"pushoversounds"`
+	Priority types.String `tfsdk:"priority" kuma:"pushoverpriority"`
+	Title    types.String `tfsdk:"title" kuma:"pushovertitle"`
+	Device   types.String `tfsdk:"device" kuma:"pushoverdevice"`
+	TTL      types.Int64  `tfsdk:"ttl" kuma:"pushoverttl"`
+}
+
+type notificationMatrixModel struct {
+	HomeserverURL  types.String `tfsdk:"homeserver_url" kuma:"homeserverUrl"`
+	InternalRoomID types.String `tfsdk:"internal_room_id" kuma:"internalRoomId"`
+	AccessToken    types.String `tfsdk:"access_token" kuma:"accessToken"`
+}
+
+type notificationMattermostModel struct {
+	WebhookURL types.String `tfsdk:"webhook_url" kuma:"mattermostWebhookUrl"`
+	Username   types.String `tfsdk:"username" kuma:"mattermostusername"`
+	Channel    types.String `tfsdk:"channel" kuma:"mattermostchannel"`
+	IconEmoji  types.String `tfsdk:"icon_emoji" kuma:"mattermosticonemo"`
+	IconURL    types.String `tfsdk:"icon_url" kuma:"mattermosticonurl"`
+}
+
+type notificationRocketChatModel struct {
+	WebhookURL types.String `tfsdk:"webhook_url" kuma:"rocketwebhookURL"`
+	Username   types.String `tfsdk:"username" kuma:"rocketusername"`
+	Channel    types.String `tfsdk:"channel" kuma:"rocketchannel"`
+	IconEmoji  types.String `tfsdk:"icon_emoji" kuma:"rocketiconemo"`
+}
+
+type notificationGoogleChatModel struct {
+	WebhookURL types.String `tfsdk:"webhook_url" kuma:"googleChatWebhookURL"`
+}
+
+type notificationSignalModel struct {
+	URL        types.String `tfsdk:"url" kuma:"signalURL"`
+	Number     types.String `tfsdk:"number" kuma:"signalNumber"`
+	Recipients types.String `tfsdk:"recipients" kuma:"signalRecipients"`
+}
+
+type notificationPushbulletModel struct {
+	AccessToken types.String `tfsdk:"access_token" kuma:"pushbulletAccessToken"`
+}
+
+// notificationBlockAttributes returns the schema of every notification block.
+// Secrets are Sensitive, which also keeps them out of debug logs.
+func notificationBlockAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"slack": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"webhook_url": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"channel": schema.StringAttribute{
+					Optional: true,
+				},
+				"username": schema.StringAttribute{
+					Optional: true,
+				},
+				"icon_emoji": schema.StringAttribute{
+					Optional: true,
+				},
+				"channel_notify": schema.BoolAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"discord": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"webhook_url": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"username": schema.StringAttribute{
+					Optional: true,
+				},
+				"prefix_message": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"teams": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"webhook_url": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+		"telegram": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"bot_token": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"chat_id": schema.StringAttribute{
+					Required: true,
+				},
+				"message_thread_id": schema.StringAttribute{
+					Optional: true,
+				},
+				"send_silently": schema.BoolAttribute{
+					Optional: true,
+				},
+				"protect_content": schema.BoolAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"smtp": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"host": schema.StringAttribute{
+					Required: true,
+				},
+				"port": schema.Int64Attribute{
+					Required: true,
+				},
+				"secure": schema.BoolAttribute{
+					Description: "Connect with TLS rather than upgrading with STARTTLS.",
+					Optional:    true,
+				},
+				"ignore_tls_error": schema.BoolAttribute{
+					Optional: true,
+				},
+				"username": schema.StringAttribute{
+					Optional: true,
+				},
+				"password": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+				},
+				"from": schema.StringAttribute{
+					Required: true,
+				},
+				"to": schema.StringAttribute{
+					Required: true,
+				},
+				"cc": schema.StringAttribute{
+					Optional: true,
+				},
+				"bcc": schema.StringAttribute{
+					Optional: true,
+				},
+				"subject": schema.StringAttribute{
+					Optional: true,
+				},
+				"body": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"webhook": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					Required: true,
+				},
+				"content_type": schema.StringAttribute{
+					Description: "json, form-data or custom.",
+					Optional:    true,
+					Computed:    true,
+					Default:     stringdefault.StaticString("json"),
+				},
+				"additional_headers": schema.StringAttribute{
+					Description: "JSON object of extra request headers.",
+					Optional:    true,
+					Sensitive:   true,
+				},
+				"custom_body": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"pagerduty": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"integration_key": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"integration_url": schema.StringAttribute{
+					Optional: true,
+				},
+				"priority": schema.StringAttribute{
+					Optional: true,
+				},
+				"auto_resolve": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"opsgenie": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"api_key": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"region": schema.StringAttribute{
+					Optional: true,
+				},
+				"priority": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+		"gotify": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"server_url": schema.StringAttribute{
+					Required: true,
+				},
+				"application_token": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"priority": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+		"ntfy": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"server_url": schema.StringAttribute{
+					Required: true,
+				},
+				"topic": schema.StringAttribute{
+					Required: true,
+				},
+				"priority": schema.Int64Attribute{
+					Optional: true,
+				},
+				"authentication_method": schema.StringAttribute{
+					Optional: true,
+				},
+				"username": schema.StringAttribute{
+					Optional: true,
+				},
+				"password": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+				},
+				"access_token": schema.StringAttribute{
+					Optional:  true,
+					Sensitive: true,
+				},
+				"icon": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"pushover": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"user_key": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"app_token": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"sounds": schema.StringAttribute{
+					Optional: true,
+				},
+				"priority": schema.StringAttribute{
+					Optional: true,
+				},
+				"title": schema.StringAttribute{
+					Optional: true,
+				},
+				"device": schema.StringAttribute{
+					Optional: true,
+				},
+				"ttl": schema.Int64Attribute{
+					Optional: true,
+				},
+			},
+		},
+		"matrix": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"homeserver_url": schema.StringAttribute{
+					Required: true,
+				},
+				"internal_room_id": schema.StringAttribute{
+					Required: true,
+				},
+				"access_token": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+		"mattermost": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"webhook_url": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"username": schema.StringAttribute{
+					Optional: true,
+				},
+				"channel": schema.StringAttribute{
+					Optional: true,
+				},
+				"icon_emoji": schema.StringAttribute{
+					Optional: true,
+				},
+				"icon_url": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"rocket_chat": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"webhook_url": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+				"username": schema.StringAttribute{
+					Optional: true,
+				},
+				"channel": schema.StringAttribute{
+					Optional: true,
+				},
+				"icon_emoji": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+		"google_chat": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"webhook_url": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+		"signal": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"url": schema.StringAttribute{
+					Required: true,
+				},
+				"number": schema.StringAttribute{
+					Required: true,
+				},
+				"recipients": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+		"pushbullet": schema.SingleNestedAttribute{
+			Optional: true,
+			Attributes: map[string]schema.Attribute{
+				"access_token": schema.StringAttribute{
+					Required:  true,
+					Sensitive: true,
+				},
+			},
+		},
+	}
+}
+
+var _ resource.ConfigValidator = notificationBlockValidator{}
+
+// notificationBlockValidator checks that exactly one notification block is
+// set. The attributes each block requires are enforced by its schema.
+type notificationBlockValidator struct{}
+
+func (v notificationBlockValidator) Description(_ context.Context) string {
+	return "Checks that exactly one notification block is set."
+}
+
+func (v notificationBlockValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v notificationBlockValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	blocks := sortedKeys(notificationBlockTypes)
+
+	// An unknown block may still turn out null, so it neither counts as set
+	// nor lets a missing block be reported until it is known.
+	var set []string
+	unknown := false
+	for _, name := range blocks {
+		var block types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &block)...)
+		switch {
+		case block.IsUnknown():
+			unknown = true
+		case !block.IsNull():
+			set = append(set, name)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case len(set) > 1:
+		resp.Diagnostics.AddAttributeError(
+			path.Root(set[1]),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Only one notification block can be set, got: %s.", strings.Join(set, ", ")),
+		)
+	case len(set) == 0 && !unknown:
+		resp.Diagnostics.AddError(
+			"Missing Notification Settings",
+			fmt.Sprintf("Set one of: %s.", strings.Join(blocks, ", ")),
+		)
+	}
+}
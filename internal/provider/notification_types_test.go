@@ -0,0 +1,80 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// notificationConfig builds the configuration of a notification resource
+// from m, with the blocks named in unknown left unknown.
+func notificationConfig(t *testing.T, m notificationResourceModel, unknown ...string) tfsdk.Config {
+	t.Helper()
+
+	ctx := context.Background()
+	state := monitorSchemaState(t, &notificationResource{})
+	if diags := state.Set(ctx, &m); diags.HasError() {
+		t.Fatalf("building the configuration: %v", diags)
+	}
+	for _, name := range unknown {
+		block := state.Schema.GetAttributes()[name].GetType().(types.ObjectType)
+		if diags := state.SetAttribute(ctx, path.Root(name), types.ObjectUnknown(block.AttrTypes)); diags.HasError() {
+			t.Fatalf("leaving %s unknown: %v", name, diags)
+		}
+	}
+
+	return tfsdk.Config{Schema: state.Schema, Raw: state.Raw}
+}
+
+func TestNotificationBlockValidator(t *testing.T) {
+	slack := &notificationSlackModel{WebhookURL: types.StringValue("https://hooks.slack.com/x")}
+	teams := &notificationTeamsModel{WebhookURL: types.StringValue("https://example.webhook.office.com/x")}
+
+	tests := map[string]struct {
+		config  notificationResourceModel
+		unknown []string
+		want    []string
+	}{
+		"one block": {
+			config: notificationResourceModel{Slack: slack},
+		},
+		"no block": {
+			want: []string{"Missing Notification Settings at "},
+		},
+		"two blocks": {
+			config: notificationResourceModel{Slack: slack, Teams: teams},
+			want:   []string{"Invalid Attribute Combination at teams"},
+		},
+		"unknown block": {
+			unknown: []string{"slack"},
+		},
+		// The unknown block may turn out null, leaving a single block.
+		"unknown and known block": {
+			config:  notificationResourceModel{Teams: teams},
+			unknown: []string{"slack"},
+		},
+		"unknown and two known blocks": {
+			config:  notificationResourceModel{Slack: slack, Teams: teams},
+			unknown: []string{"discord"},
+			want:    []string{"Invalid Attribute Combination at teams"},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			tt.config.Name = types.StringValue("ops")
+			req := resource.ValidateConfigRequest{Config: notificationConfig(t, tt.config, tt.unknown...)}
+			var resp resource.ValidateConfigResponse
+			notificationBlockValidator{}.ValidateResource(context.Background(), req, &resp)
+
+			if got := diagnosticsAt(resp.Diagnostics); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diagnostics = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -2,20 +2,22 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
-	"io"
-	"net/http"
-	"net/url"
+	"errors"
+	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -37,6 +39,24 @@ type uptimeKumaProviderModel struct {
 	Host     types.String `tfsdk:"host"`
 	Username types.String `tfsdk:"username"`
 	Password types.String `tfsdk:"password"`
+	APIKey   types.String `tfsdk:"api_key"`
+	Token    types.String `tfsdk:"token"`
+	Backend  types.String `tfsdk:"backend"`
+
+	TOTPSecret types.String `tfsdk:"totp_secret"`
+
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin      types.String  `tfsdk:"retry_wait_min"`
+	RetryWaitMax      types.String  `tfsdk:"retry_wait_max"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+
+	CACertPEM          types.String `tfsdk:"ca_cert_pem"`
+	CACertFile         types.String `tfsdk:"ca_cert_file"`
+	ClientCertPEM      types.String `tfsdk:"client_cert_pem"`
+	ClientKeyPEM       types.String `tfsdk:"client_key_pem"`
+	InsecureSkipVerify types.Bool   `tfsdk:"insecure_skip_verify"`
+	HTTPProxy          types.String `tfsdk:"http_proxy"`
+	RequestTimeout     types.String `tfsdk:"request_timeout"`
 }
 
 type uptimeKumaProvider struct {
@@ -46,12 +66,6 @@ type uptimeKumaProvider struct {
 	version string
 }
 
-// NOTE: this is interpreted as different types in other resources
-type authData struct {
-	Host  string
-	Token string
-}
-
 // Metadata returns the provider type name.
 func (p *uptimeKumaProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
 	resp.TypeName = "uptime-kuma"
@@ -63,21 +77,98 @@ func (p *uptimeKumaProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Base URL of the REST bridge, or of Uptime Kuma itself with the socketio backend. Can also be set with the KUMA_HOST environment variable.",
 			},
 			"username": schema.StringAttribute{
-				Required: true,
+				Optional:    true,
+				Description: "Uptime Kuma username. Can also be set with the KUMA_USERNAME or KUMA_USERNAME_FILE environment variables.",
 			},
 			"password": schema.StringAttribute{
-				Required:  true,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Uptime Kuma password. Can also be set with the KUMA_PASSWORD or KUMA_PASSWORD_FILE environment variables.",
+			},
+			"api_key": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Uptime Kuma API key, used instead of logging in with username and password. Only supported by the " +
+					"rest_bridge backend. Conflicts with token. Can also be set with the KUMA_API_KEY or KUMA_API_KEY_FILE environment variables.",
+			},
+			"token": schema.StringAttribute{
+				Optional:  true,
+				Sensitive: true,
+				Description: "Session token from an earlier login, used instead of logging in. When it expires the provider logs in " +
+					"with username and password if those are set. Conflicts with api_key. Can also be set with the KUMA_TOKEN or " +
+					"KUMA_TOKEN_FILE environment variables.",
+			},
+			"totp_secret": schema.StringAttribute{
+				Optional:  true,
 				Sensitive: true,
+				Description: "Base32 secret of the account's two-factor authentication, as shown when 2FA was enabled. The provider " +
+					"computes the current code and sends it with every login. Can also be set with the KUMA_TOTP_SECRET or " +
+					"KUMA_TOTP_SECRET_FILE environment variables.",
+			},
+			"backend": schema.StringAttribute{
+				Optional: true,
+				Description: "How the provider talks to Uptime Kuma: \"rest_bridge\" (default) goes through the " +
+					"uptimekuma_restapi bridge at host, \"socketio\" talks to the Uptime Kuma instance at host directly. " +
+					"Can also be set with the KUMA_BACKEND environment variable.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Description: "How many times a failed API call is retried. Reads, updates and deletes are retried on " +
+					"connection errors, 5xx and 429 responses; creates only when the connection could not be established. Defaults to 3.",
+			},
+			"retry_wait_min": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum wait between retries, as a Go duration such as \"500ms\". Defaults to \"1s\".",
+			},
+			"retry_wait_max": schema.StringAttribute{
+				Optional:    true,
+				Description: "Maximum wait between retries, as a Go duration such as \"1m\". Defaults to \"30s\".",
+			},
+			"requests_per_second": schema.Float64Attribute{
+				Optional:    true,
+				Description: "Maximum number of API requests per second, retries and logins included, shared by all resources and data sources. Unlimited when unset.",
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded CA bundle to trust in addition to the system roots. Conflicts with ca_cert_file.",
+			},
+			"ca_cert_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to a PEM-encoded CA bundle to trust in addition to the system roots. Conflicts with ca_cert_pem.",
+			},
+			"client_cert_pem": schema.StringAttribute{
+				Optional:    true,
+				Description: "PEM-encoded client certificate for mutual TLS. Requires client_key_pem.",
+			},
+			"client_key_pem": schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM-encoded private key for client_cert_pem.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Skip verification of the server's TLS certificate. Only use this for testing.",
+			},
+			"http_proxy": schema.StringAttribute{
+				Optional:    true,
+				Description: "URL of an HTTP(S) proxy for all requests. Defaults to the HTTP_PROXY/HTTPS_PROXY/NO_PROXY environment variables.",
+			},
+			"request_timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: "Timeout for each API request, including login, as a Go duration such as \"30s\". No timeout when unset.",
 			},
 		},
 	}
 }
 
-// Configure prepares a HashiCups API client for data sources and resources.
+// Configure prepares an Uptime Kuma API client for data sources and resources.
 func (p *uptimeKumaProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	ctx = withRedaction(ctx)
+
 	// Retrieve provider data from configuration
 	var config uptimeKumaProviderModel
 	diags := req.Config.Get(ctx, &config)
@@ -92,51 +183,112 @@ func (p *uptimeKumaProvider) Configure(ctx context.Context, req provider.Configu
 	if config.Host.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
-			"Unknown HashiCups API Host",
-			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the HashiCups API host. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_HOST environment variable.",
+			"Unknown Uptime-Kuma API Host",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the Uptime Kuma API host. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_HOST environment variable.",
 		)
 	}
 
 	if config.Username.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
-			"Unknown HashiCups API Username",
-			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the HashiCups API username. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_USERNAME environment variable.",
+			"Unknown Uptime-Kuma API Username",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the Uptime Kuma username. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_USERNAME environment variable.",
 		)
 	}
 
 	if config.Password.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
-			"Unknown HashiCups API Password",
-			"The provider cannot create the HashiCups API client as there is an unknown configuration value for the HashiCups API password. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the HASHICUPS_PASSWORD environment variable.",
+			"Unknown Uptime-Kuma API Password",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the Uptime Kuma password. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_PASSWORD environment variable.",
 		)
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if config.APIKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown Uptime-Kuma API Key",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the Uptime Kuma API key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_API_KEY environment variable.",
+		)
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	if config.Token.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token"),
+			"Unknown Uptime-Kuma API Token",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the Uptime Kuma token. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_TOKEN environment variable.",
+		)
+	}
 
-	host := os.Getenv("KUMA_HOST")
-	username := os.Getenv("KUMA_USERNAME")
-	password := os.Getenv("KUMA_PASSWORD")
+	if config.TOTPSecret.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("totp_secret"),
+			"Unknown Uptime-Kuma TOTP Secret",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the Uptime Kuma TOTP secret. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_TOTP_SECRET environment variable.",
+		)
+	}
+
+	if config.Backend.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backend"),
+			"Unknown Uptime-Kuma Backend",
+			"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for the backend. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the KUMA_BACKEND environment variable.",
+		)
+	}
 
-	if !config.Host.IsNull() {
-		host = config.Host.ValueString()
+	// Unknown connection settings would read as empty and quietly fall back
+	// to the defaults, such as the system trust store in place of a private
+	// CA or no rate limit in place of a computed one.
+	for _, setting := range []struct {
+		name    string
+		unknown bool
+	}{
+		{"ca_cert_pem", config.CACertPEM.IsUnknown()},
+		{"ca_cert_file", config.CACertFile.IsUnknown()},
+		{"client_cert_pem", config.ClientCertPEM.IsUnknown()},
+		{"client_key_pem", config.ClientKeyPEM.IsUnknown()},
+		{"insecure_skip_verify", config.InsecureSkipVerify.IsUnknown()},
+		{"http_proxy", config.HTTPProxy.IsUnknown()},
+		{"request_timeout", config.RequestTimeout.IsUnknown()},
+		{"max_retries", config.MaxRetries.IsUnknown()},
+		{"retry_wait_min", config.RetryWaitMin.IsUnknown()},
+		{"retry_wait_max", config.RetryWaitMax.IsUnknown()},
+		{"requests_per_second", config.RequestsPerSecond.IsUnknown()},
+	} {
+		if setting.unknown {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(setting.name),
+				"Unknown Uptime-Kuma Connection Setting",
+				"The provider cannot create the Uptime Kuma API client as there is an unknown configuration value for "+setting.name+". "+
+					"Either target apply the source of the value first or set the value statically in the configuration.",
+			)
+		}
 	}
 
-	if !config.Username.IsNull() {
-		username = config.Username.ValueString()
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	if !config.Password.IsNull() {
-		password = config.Password.ValueString()
+	// Default values to environment variables, but override
+	// with Terraform configuration value if set.
+
+	host := configOrEnv(config.Host, "KUMA_HOST", path.Root("host"), &resp.Diagnostics)
+	username := configOrEnv(config.Username, "KUMA_USERNAME", path.Root("username"), &resp.Diagnostics)
+	password := configOrEnv(config.Password, "KUMA_PASSWORD", path.Root("password"), &resp.Diagnostics)
+	apiKey := configOrEnv(config.APIKey, "KUMA_API_KEY", path.Root("api_key"), &resp.Diagnostics)
+	token := configOrEnv(config.Token, "KUMA_TOKEN", path.Root("token"), &resp.Diagnostics)
+	totpSecret := configOrEnv(config.TOTPSecret, "KUMA_TOTP_SECRET", path.Root("totp_secret"), &resp.Diagnostics)
+	backend := os.Getenv("KUMA_BACKEND")
+
+	if !config.Backend.IsNull() {
+		backend = config.Backend.ValueString()
 	}
 
 	// If any of the expected configurations are missing, return
@@ -146,99 +298,292 @@ func (p *uptimeKumaProvider) Configure(ctx context.Context, req provider.Configu
 		resp.Diagnostics.AddAttributeError(
 			path.Root("host"),
 			"Missing Uptime-Kuma API Host",
-			"The provider cannot create the HashiCups API client as there is a missing or empty value for the HashiCups API host. "+
-				"Set the host value in the configuration or use the HASHICUPS_HOST environment variable. "+
+			"The provider cannot create the Uptime Kuma API client as there is a missing or empty value for the Uptime Kuma API host. "+
+				"Set the host value in the configuration or use the KUMA_HOST environment variable. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if username == "" {
+	// A token or API key replaces the login, so username and password are
+	// only required without one.
+	loginRequired := apiKey == "" && token == ""
+
+	if apiKey != "" && token != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Conflicting Uptime-Kuma Credentials",
+			"Only one of api_key and token may be set.",
+		)
+	}
+
+	if loginRequired && username == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("username"),
 			"Missing Uptime-Kuma API Username",
-			"The provider cannot create the HashiCups API client as there is a missing or empty value for the HashiCups API username. "+
-				"Set the username value in the configuration or use the HASHICUPS_USERNAME environment variable. "+
+			"The provider cannot create the Uptime Kuma API client as there is a missing or empty value for the Uptime Kuma username. "+
+				"Set the username value in the configuration or use the KUMA_USERNAME (or KUMA_USERNAME_FILE) environment variable, "+
+				"or authenticate with api_key or token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if password == "" {
+	if loginRequired && password == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("password"),
 			"Missing Uptime-Kuma API Password",
-			"The provider cannot create the HashiCups API client as there is a missing or empty value for the HashiCups API password. "+
-				"Set the password value in the configuration or use the HASHICUPS_PASSWORD environment variable. "+
+			"The provider cannot create the Uptime Kuma API client as there is a missing or empty value for the Uptime Kuma password. "+
+				"Set the password value in the configuration or use the KUMA_PASSWORD (or KUMA_PASSWORD_FILE) environment variable, "+
+				"or authenticate with api_key or token instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
-	if resp.Diagnostics.HasError() {
-		return
+	if backend == "" {
+		backend = kumaclient.BackendRESTBridge
 	}
 
-	loginForm := url.Values{
-		"username": {username},
-		"password": {password},
+	if backend != kumaclient.BackendRESTBridge && backend != kumaclient.BackendSocketIO {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backend"),
+			"Invalid Uptime-Kuma Backend",
+			"The backend must be either \""+kumaclient.BackendRESTBridge+"\" or \""+kumaclient.BackendSocketIO+"\", got \""+backend+"\".",
+		)
 	}
 
-	type LoginResponse struct {
-		Access_Token string
-		Token_Type   string
+	if backend == kumaclient.BackendSocketIO && apiKey != "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unsupported Uptime-Kuma Credentials",
+			"Uptime Kuma's Socket.IO API does not accept API keys. Use token, or username and password, with the socketio backend.",
+		)
 	}
 
-	ctx = tflog.SetField(ctx, "host", host)
-	ctx = tflog.SetField(ctx, "username", username)
-	ctx = tflog.SetField(ctx, "password", password)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "password")
+	maxRetries := kumaclient.DefaultMaxRetries
+	if !config.MaxRetries.IsNull() {
+		maxRetries = int(config.MaxRetries.ValueInt64())
+		if maxRetries < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retries"),
+				"Invalid Uptime-Kuma Retry Setting",
+				"max_retries must not be negative.",
+			)
+		}
+	}
 
-	tflog.Info(ctx, "Getting auth token")
+	retryWaitMin := parseDurationAttribute(config.RetryWaitMin, path.Root("retry_wait_min"), kumaclient.DefaultRetryWaitMin, &resp.Diagnostics)
+	retryWaitMax := parseDurationAttribute(config.RetryWaitMax, path.Root("retry_wait_max"), kumaclient.DefaultRetryWaitMax, &resp.Diagnostics)
+	if retryWaitMax < retryWaitMin {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_max"),
+			"Invalid Uptime-Kuma Retry Setting",
+			fmt.Sprintf("retry_wait_max (%s) must not be shorter than retry_wait_min (%s).", retryWaitMax, retryWaitMin),
+		)
+	}
 
-	loginResp, err := http.Post(host+"/login/access-token", "application/x-www-form-urlencoded", strings.NewReader(loginForm.Encode()))
-	if err != nil {
+	var requestsPerSecond float64
+	if !config.RequestsPerSecond.IsNull() {
+		requestsPerSecond = config.RequestsPerSecond.ValueFloat64()
+		if requestsPerSecond < 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requests_per_second"),
+				"Invalid Uptime-Kuma Rate Limit",
+				"requests_per_second must not be negative.",
+			)
+		}
+	}
+
+	tlsConfig := kumaclient.TLSConfig{
+		CACertPEM:          config.CACertPEM.ValueString(),
+		ClientCertPEM:      config.ClientCertPEM.ValueString(),
+		ClientKeyPEM:       config.ClientKeyPEM.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+		ProxyURL:           config.HTTPProxy.ValueString(),
+	}
+
+	if !config.CACertFile.IsNull() {
+		if tlsConfig.CACertPEM != "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Conflicting Uptime-Kuma CA Settings",
+				"Only one of ca_cert_pem and ca_cert_file may be set.",
+			)
+		}
+
+		caCert, err := os.ReadFile(config.CACertFile.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read Uptime-Kuma CA Bundle",
+				err.Error(),
+			)
+		}
+		tlsConfig.CACertPEM = string(caCert)
+	}
+
+	if (tlsConfig.ClientCertPEM == "") != (tlsConfig.ClientKeyPEM == "") {
 		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"Error logging in to uptime-kuma api",
-			err.Error(),
+			path.Root("client_cert_pem"),
+			"Incomplete Uptime-Kuma Client Certificate",
+			"client_cert_pem and client_key_pem must be set together.",
 		)
 	}
 
-	defer loginResp.Body.Close()
-	loginBody, err := io.ReadAll(loginResp.Body)
+	requestTimeout := parseDurationAttribute(config.RequestTimeout, path.Root("request_timeout"), 0, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "host", host)
+	ctx = tflog.SetField(ctx, "backend", backend)
+	ctx = tflog.SetField(ctx, "username", username)
+	ctx = tflog.SetField(ctx, "totp", totpSecret != "")
+
+	if loginRequired {
+		tflog.Info(ctx, "Getting auth token")
+	} else {
+		tflog.Info(ctx, "Using configured token or API key")
+	}
+
+	client, err := kumaclient.New(ctx, kumaclient.Config{
+		Host:     host,
+		Username: username,
+		Password: password,
+		Token:    token,
+		APIKey:   apiKey,
+		Backend:  backend,
+
+		TOTPSecret: totpSecret,
+
+		MaxRetries:        maxRetries,
+		RetryWaitMin:      retryWaitMin,
+		RetryWaitMax:      retryWaitMax,
+		RequestsPerSecond: requestsPerSecond,
+
+		TLS:            tlsConfig,
+		RequestTimeout: requestTimeout,
+	})
 	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
+		credentialsPath := path.Root("password")
+		switch {
+		case apiKey != "":
+			credentialsPath = path.Root("api_key")
+		case token != "":
+			credentialsPath = path.Root("token")
+		}
+		addLoginError(&resp.Diagnostics, err, host, credentialsPath)
+		return
+	}
+
+	// Make the client available to data sources and resources
+	resp.DataSourceData = client
+	resp.ResourceData = client
+
+	tflog.Info(ctx, "Successfully configured the Uptime Kuma client", map[string]any{"success": true})
+}
+
+// addLoginError turns a failure from kumaclient.New into a diagnostic that
+// points at the setting most likely to be wrong.
+func addLoginError(diags *diag.Diagnostics, err error, host string, credentialsPath path.Path) {
+	switch {
+	case errors.Is(err, kumaclient.ErrInvalidConfig):
+		diags.AddError(
+			"Invalid Uptime-Kuma Provider Configuration",
+			"The provider cannot create the Uptime Kuma API client: "+err.Error(),
+		)
+	case errors.Is(err, kumaclient.ErrTOTPRequired):
+		diags.AddAttributeError(
+			path.Root("totp_secret"),
+			"Uptime-Kuma Two-Factor Code Required",
+			"The Uptime Kuma account has two-factor authentication enabled, so logging in needs a one-time code. "+
+				"Set totp_secret in the provider configuration or use the KUMA_TOTP_SECRET (or KUMA_TOTP_SECRET_FILE) environment variable.\n\n"+
+				err.Error(),
+		)
+	case errors.Is(err, kumaclient.ErrUnauthorized):
+		diags.AddAttributeError(
+			credentialsPath,
+			"Invalid Uptime-Kuma Credentials",
+			"Uptime Kuma rejected the configured credentials. Check the username and password, token or API key.\n\n"+
+				err.Error(),
+		)
+	case kumaclient.IsTLSError(err):
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Uptime-Kuma TLS Error",
+			"The TLS connection to "+host+" could not be verified. If the server uses a private CA, set ca_cert_pem or "+
+				"ca_cert_file; check that host uses the right scheme and name.\n\n"+
+				err.Error(),
+		)
+	case kumaclient.IsConnectionError(err):
+		diags.AddAttributeError(
+			path.Root("host"),
+			"Unable to Connect to Uptime-Kuma",
+			"The provider could not reach "+host+". Check the host value, network access and proxy settings.\n\n"+
+				err.Error(),
+		)
+	default:
+		diags.AddAttributeError(
+			credentialsPath,
 			"Error logging in to uptime-kuma api",
 			err.Error(),
 		)
 	}
+}
 
-	var loginJson LoginResponse
-	decodeErr := json.Unmarshal(loginBody, &loginJson)
-	if decodeErr != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("password"),
-			"error decoding response",
-			err.Error(),
-		)
+// configOrEnv returns the configured value v or, when it is null, falls back
+// to envOrFile. Only the fallback touches the environment and files.
+func configOrEnv(v types.String, name string, p path.Path, diags *diag.Diagnostics) string {
+	if !v.IsNull() {
+		return v.ValueString()
 	}
 
-	//Make our authentication data available
+	return envOrFile(name, p, diags)
+}
 
-	auth, err := json.Marshal(authData{
-		Host:  host,
-		Token: loginJson.Access_Token,
-	})
+// envOrFile returns the value of the environment variable name or, when that
+// is unset, the contents of the file named by name+"_FILE" with surrounding
+// whitespace removed. The latter lets CI systems mount secrets as files.
+func envOrFile(name string, p path.Path, diags *diag.Diagnostics) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+
+	file := os.Getenv(name + "_FILE")
+	if file == "" {
+		return ""
+	}
+
+	data, err := os.ReadFile(file)
 	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error marshalling auth data for later use",
-			err.Error(),
+		diags.AddAttributeError(
+			p,
+			"Unable to Read "+name+"_FILE",
+			fmt.Sprintf("The provider could not read %s from the file named by %s_FILE: %s", name, name, err),
 		)
+		return ""
 	}
 
-	resp.DataSourceData = auth
-	resp.ResourceData = auth
+	return strings.TrimSpace(string(data))
+}
+
+// parseDurationAttribute parses a duration-valued provider attribute, falling
+// back to def when it is not set.
+func parseDurationAttribute(v types.String, p path.Path, def time.Duration, diags *diag.Diagnostics) time.Duration {
+	if v.IsNull() {
+		return def
+	}
 
-	tflog.Info(ctx, "Successfully got an auth token", map[string]any{"success": true})
+	d, err := time.ParseDuration(v.ValueString())
+	if err != nil || d < 0 {
+		diags.AddAttributeError(
+			p,
+			"Invalid Uptime-Kuma Duration",
+			fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"30s\", got %q.", v.ValueString()),
+		)
+		return def
+	}
+
+	return d
 }
 
 // DataSources defines the data sources implemented in the provider.
@@ -254,7 +599,12 @@ func (p *uptimeKumaProvider) DataSources(_ context.Context) []func() datasource.
 
 // Resources defines the resources implemented in the provider.
 func (p *uptimeKumaProvider) Resources(_ context.Context) []func() resource.Resource {
-	return []func() resource.Resource{
+	resources := []func() resource.Resource{
 		NewMonitorResource,
+		NewTagResource,
+		NewMonitorTagResource,
+		NewNotificationResource,
 	}
+
+	return append(resources, typedMonitorResources()...)
 }
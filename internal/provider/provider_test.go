@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+func TestAddLoginError(t *testing.T) {
+	tests := map[string]struct {
+		status  int
+		body    string
+		closed  bool
+		summary string
+		path    path.Path
+	}{
+		"non-200 status": {
+			status:  http.StatusInternalServerError,
+			body:    `{"detail":"Internal Server Error"}`,
+			summary: "Error logging in to uptime-kuma api",
+			path:    path.Root("password"),
+		},
+		"400 bad credentials": {
+			status:  http.StatusBadRequest,
+			body:    `{"detail":"Incorrect username or password"}`,
+			summary: "Invalid Uptime-Kuma Credentials",
+			path:    path.Root("password"),
+		},
+		"HTML body": {
+			status:  http.StatusOK,
+			body:    `<!DOCTYPE html><html></html>`,
+			summary: "Error logging in to uptime-kuma api",
+			path:    path.Root("password"),
+		},
+		"empty access_token": {
+			status:  http.StatusOK,
+			body:    `{"access_token":"","token_type":"bearer"}`,
+			summary: "Error logging in to uptime-kuma api",
+			path:    path.Root("password"),
+		},
+		"connection refused": {
+			closed:  true,
+			summary: "Unable to Connect to Uptime-Kuma",
+			path:    path.Root("host"),
+		},
+		"TOTP required": {
+			status:  http.StatusBadRequest,
+			body:    `{"detail":"tokenRequired"}`,
+			summary: "Uptime-Kuma Two-Factor Code Required",
+			path:    path.Root("totp_secret"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			host := srv.URL
+			if tt.closed {
+				srv.Close()
+			} else {
+				defer srv.Close()
+			}
+
+			_, err := kumaclient.New(context.Background(), kumaclient.Config{
+				Host:     host,
+				Username: "admin",
+				Password: "hunter2",
+			})
+			if err == nil {
+				t.Fatal("kumaclient.New succeeded, want a login error")
+			}
+
+			var diags diag.Diagnostics
+			addLoginError(&diags, err, host, path.Root("password"))
+
+			if len(diags) != 1 {
+				t.Fatalf("got %d diagnostics, want 1: %v", len(diags), diags)
+			}
+			if got := diags[0].Summary(); got != tt.summary {
+				t.Errorf("summary = %q, want %q", got, tt.summary)
+			}
+			withPath, ok := diags[0].(diag.DiagnosticWithPath)
+			if !ok {
+				t.Fatalf("diagnostic has no attribute path: %v", diags[0])
+			}
+			if !withPath.Path().Equal(tt.path) {
+				t.Errorf("path = %s, want %s", withPath.Path(), tt.path)
+			}
+		})
+	}
+}
+
+func TestConfigOrEnv(t *testing.T) {
+	dir := t.TempDir()
+	secretFile := filepath.Join(dir, "password")
+	if err := os.WriteFile(secretFile, []byte("from-file\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	missingFile := filepath.Join(dir, "missing")
+
+	tests := map[string]struct {
+		config  types.String
+		env     string
+		file    string
+		want    string
+		wantErr bool
+	}{
+		"config wins over env and file": {
+			config: types.StringValue("from-config"),
+			env:    "from-env",
+			file:   secretFile,
+			want:   "from-config",
+		},
+		"env wins over file": {
+			config: types.StringNull(),
+			env:    "from-env",
+			file:   secretFile,
+			want:   "from-env",
+		},
+		"file with trailing newline": {
+			config: types.StringNull(),
+			file:   secretFile,
+			want:   "from-file",
+		},
+		"unset": {
+			config: types.StringNull(),
+		},
+		"unreadable file": {
+			config:  types.StringNull(),
+			file:    missingFile,
+			wantErr: true,
+		},
+		// The file is never needed, so it is not read.
+		"config with unreadable file": {
+			config: types.StringValue("from-config"),
+			file:   missingFile,
+			want:   "from-config",
+		},
+		"empty config is still set": {
+			config: types.StringValue(""),
+			env:    "from-env",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv("KUMA_PASSWORD", tt.env)
+			t.Setenv("KUMA_PASSWORD_FILE", tt.file)
+
+			var diags diag.Diagnostics
+			got := configOrEnv(tt.config, "KUMA_PASSWORD", path.Root("password"), &diags)
+
+			if got != tt.want {
+				t.Errorf("configOrEnv = %q, want %q", got, tt.want)
+			}
+			if diags.HasError() != tt.wantErr {
+				t.Errorf("diagnostics = %v, want error %t", diags, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestConfigureRejectsUnknownConnectionSettings(t *testing.T) {
+	ctx := context.Background()
+	p := &uptimeKumaProvider{}
+
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	for _, name := range []string{
+		"ca_cert_pem", "ca_cert_file", "client_cert_pem", "client_key_pem", "insecure_skip_verify", "http_proxy",
+		"request_timeout", "max_retries", "retry_wait_min", "retry_wait_max", "requests_per_second",
+	} {
+		t.Run(name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for attr, typ := range objectType.AttributeTypes {
+				values[attr] = tftypes.NewValue(typ, nil)
+			}
+			values["host"] = tftypes.NewValue(tftypes.String, "http://kuma.invalid")
+			values["username"] = tftypes.NewValue(tftypes.String, "admin")
+			values["password"] = tftypes.NewValue(tftypes.String, "hunter2")
+			values[name] = tftypes.NewValue(objectType.AttributeTypes[name], tftypes.UnknownValue)
+
+			req := provider.ConfigureRequest{Config: tfsdk.Config{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(objectType, values),
+			}}
+			var resp provider.ConfigureResponse
+			p.Configure(ctx, req, &resp)
+
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("diagnostics = %v, want one error", resp.Diagnostics)
+			}
+			d, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+			if !ok || !d.Path().Equal(path.Root(name)) || d.Summary() != "Unknown Uptime-Kuma Connection Setting" {
+				t.Errorf("diagnostic = %v, want an unknown setting error at %s", resp.Diagnostics.Errors()[0], name)
+			}
+			if resp.ResourceData != nil {
+				t.Error("Configure built a client from an unknown setting")
+			}
+		})
+	}
+}
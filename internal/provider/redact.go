@@ -0,0 +1,111 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+var (
+	sensitiveKeysOnce sync.Once
+	sensitiveKeys     []string
+)
+
+// withRedaction returns ctx with every log field named after a Sensitive
+// attribute masked, in any schema of the provider. Every CRUD and Read entry
+// point runs its logging through it, so adding Sensitive to a new attribute is
+// all it takes to keep that attribute out of TF_LOG output. HTTP traces are
+// redacted separately by kumaclient.
+func withRedaction(ctx context.Context) context.Context {
+	sensitiveKeysOnce.Do(func() {
+		sensitiveKeys = collectSensitiveKeys(context.Background())
+	})
+
+	return tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveKeys...)
+}
+
+// collectSensitiveKeys walks the provider, resource and data source schemas
+// and returns the names of all attributes marked Sensitive, plus the keys
+// kumaclient treats as secrets in API payloads.
+func collectSensitiveKeys(ctx context.Context) []string {
+	keys := map[string]bool{}
+
+	p := &uptimeKumaProvider{}
+
+	var providerSchema provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &providerSchema)
+	addSensitiveAttributes(keys, providerSchema.Schema.GetAttributes())
+
+	for _, newResource := range p.Resources(ctx) {
+		var resp resource.SchemaResponse
+		newResource().Schema(ctx, resource.SchemaRequest{}, &resp)
+		addSensitiveAttributes(keys, resp.Schema.GetAttributes())
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		var resp datasource.SchemaResponse
+		newDataSource().Schema(ctx, datasource.SchemaRequest{}, &resp)
+		addSensitiveAttributes(keys, resp.Schema.GetAttributes())
+	}
+
+	out := make([]string, 0, len(keys))
+	for k := range keys {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+
+	return out
+}
+
+type sensitiveAttribute interface {
+	IsSensitive() bool
+}
+
+// addSensitiveAttributes records the Sensitive attributes in attrs, descending
+// into nested attributes. The nested object types live in the framework's
+// internal packages, so they are reached through reflection.
+func addSensitiveAttributes[M ~map[string]A, A sensitiveAttribute](keys map[string]bool, attrs M) {
+	for name, attr := range attrs {
+		if attr.IsSensitive() || kumaclient.IsSensitiveKey(name) {
+			keys[name] = true
+		}
+		addNestedSensitiveAttributes(keys, reflect.ValueOf(attr))
+	}
+}
+
+func addNestedSensitiveAttributes(keys map[string]bool, attr reflect.Value) {
+	getNested := attr.MethodByName("GetNestedObject")
+	if !getNested.IsValid() {
+		return
+	}
+
+	nested := getNested.Call(nil)[0]
+	if nested.Kind() == reflect.Interface && nested.IsNil() {
+		return
+	}
+
+	getAttributes := nested.MethodByName("GetAttributes")
+	if !getAttributes.IsValid() {
+		return
+	}
+
+	iter := getAttributes.Call(nil)[0].MapRange()
+	for iter.Next() {
+		nested, ok := iter.Value().Interface().(sensitiveAttribute)
+		if !ok {
+			continue
+		}
+		if nested.IsSensitive() || kumaclient.IsSensitiveKey(iter.Key().String()) {
+			keys[iter.Key().String()] = true
+		}
+		addNestedSensitiveAttributes(keys, iter.Value())
+	}
+}
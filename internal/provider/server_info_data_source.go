@@ -2,19 +2,18 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	"github.com/carlmjohnson/requests"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
 )
 
 type data_serverInfoAuth struct {
-	Host  string
-	Token string
+	client *kumaclient.Client
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -36,16 +35,16 @@ func (d *data_serverInfoAuth) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	authbytes, ok := req.ProviderData.([]byte)
+	client, ok := req.ProviderData.(*kumaclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected []byte, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	json.Unmarshal(authbytes, &d)
+	d.client = client
 
 }
 
@@ -77,14 +76,9 @@ type serverInfoModel struct {
 	ServerTimezoneOffset types.String `tfsdk:"server_timezone_offset"`
 }
 
-type JSON_serverInfoModel struct {
-	PrimaryBaseUrl       string `json:"primaryBaseUrl"`
-	ServerTimezone       string `json:"serverTimezone"`
-	ServerTimezoneOffset string `json:"serverTimezoneOffset"`
-}
-
 // Read refreshes the Terraform state with the latest data.
 func (d *data_serverInfoAuth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withRedaction(ctx)
 
 	var state serverInfoModel
 
@@ -94,36 +88,20 @@ func (d *data_serverInfoAuth) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	tflog.Debug(ctx, "Requesting "+d.Host+"/info/")
+	tflog.Debug(ctx, "Requesting server info")
 
-	var responseString string
-	err := requests.
-		URL(d.Host).
-		Bearer(d.Token).
-		Path("/info/").
-		ToString(&responseString).
-		Fetch(ctx)
+	info, err := d.client.GetServerInfo(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error with response",
 			"got "+err.Error(),
 		)
+		return
 	}
 
-	var response JSON_serverInfoModel
-	err = json.Unmarshal([]byte(responseString), &response)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error unmarshalling response",
-			err.Error(),
-		)
-	}
-
-	tflog.Debug(ctx, "Got tag data: "+responseString)
-
-	state.PrimaryBaseUrl = types.StringValue(response.PrimaryBaseUrl)
-	state.ServerTimezone = types.StringValue(response.ServerTimezone)
-	state.ServerTimezoneOffset = types.StringValue(response.ServerTimezoneOffset)
+	state.PrimaryBaseUrl = types.StringValue(info.PrimaryBaseUrl)
+	state.ServerTimezone = types.StringValue(info.ServerTimezone)
+	state.ServerTimezoneOffset = types.StringValue(info.ServerTimezoneOffset)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Set = statusCodesValidator{}
+
+// statusCodesValidator checks accepted_statuscodes entries, which Uptime
+// Kuma expects to be a status code such as "404" or an inclusive range such
+// as "200-299".
+type statusCodesValidator struct{}
+
+func (v statusCodesValidator) Description(_ context.Context) string {
+	return `Each entry must be an HTTP status code such as "404" or a range such as "200-299".`
+}
+
+func (v statusCodesValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v statusCodesValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, elem := range req.ConfigValue.Elements() {
+		code, ok := elem.(types.String)
+		if !ok || code.IsNull() || code.IsUnknown() {
+			continue
+		}
+
+		if err := parseStatusCodes(code.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid Status Code",
+				fmt.Sprintf("Entry %q is invalid: %s. %s", code.ValueString(), err, v.Description(ctx)),
+			)
+		}
+	}
+}
+
+// parseStatusCodes checks a single status code or range.
+func parseStatusCodes(s string) error {
+	low, high, isRange := strings.Cut(s, "-")
+	if !isRange {
+		high = low
+	}
+
+	from, err := parseStatusCode(low)
+	if err != nil {
+		return err
+	}
+	to, err := parseStatusCode(high)
+	if err != nil {
+		return err
+	}
+	if from > to {
+		return fmt.Errorf("the range starts after it ends")
+	}
+
+	return nil
+}
+
+func parseStatusCode(s string) (int, error) {
+	code, err := strconv.Atoi(s)
+	if err != nil || len(s) != 3 {
+		return 0, fmt.Errorf("%q is not a three digit status code", s)
+	}
+	if code < 100 || code > 599 {
+		return 0, fmt.Errorf("%d is outside 100-599", code)
+	}
+
+	return code, nil
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseStatusCodes(t *testing.T) {
+	tests := map[string]bool{
+		"200":     true,
+		"404":     true,
+		"200-299": true,
+		"100-599": true,
+		"301-301": true,
+
+		"":        false,
+		"20":      false,
+		"2000":    false,
+		"abc":     false,
+		"600":     false,
+		"099":     false,
+		"299-200": false,
+		"200-":    false,
+		"-299":    false,
+		"200-2xx": false,
+		"+20":     false,
+	}
+
+	for in, valid := range tests {
+		err := parseStatusCodes(in)
+		if valid && err != nil {
+			t.Errorf("parseStatusCodes(%q) = %v, want no error", in, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("parseStatusCodes(%q) succeeded, want an error", in)
+		}
+	}
+}
+
+func TestStatusCodesValidator(t *testing.T) {
+	tests := map[string]struct {
+		value  types.Set
+		errors int
+	}{
+		"null":    {value: types.SetNull(types.StringType)},
+		"unknown": {value: types.SetUnknown(types.StringType)},
+		"valid": {value: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringValue("200-299"),
+			types.StringValue("404"),
+		})},
+		"unknown element": {value: types.SetValueMust(types.StringType, []attr.Value{
+			types.StringUnknown(),
+		})},
+		"one invalid": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("200-299"),
+				types.StringValue("2xx"),
+			}),
+			errors: 1,
+		},
+		"two invalid": {
+			value: types.SetValueMust(types.StringType, []attr.Value{
+				types.StringValue("700"),
+				types.StringValue("299-200"),
+			}),
+			errors: 2,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := validator.SetRequest{
+				Path:        path.Root("accepted_statuscodes"),
+				ConfigValue: tt.value,
+			}
+			var resp validator.SetResponse
+			statusCodesValidator{}.ValidateSet(context.Background(), req, &resp)
+
+			if got := resp.Diagnostics.ErrorsCount(); got != tt.errors {
+				t.Errorf("got %d errors, want %d: %v", got, tt.errors, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -2,19 +2,18 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	"github.com/carlmjohnson/requests"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
 )
 
 type data_tagAuth struct {
-	Host  string
-	Token string
+	client *kumaclient.Client
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -36,16 +35,16 @@ func (d *data_tagAuth) Configure(_ context.Context, req datasource.ConfigureRequ
 		return
 	}
 
-	authbytes, ok := req.ProviderData.([]byte)
+	client, ok := req.ProviderData.(*kumaclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected []byte, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	json.Unmarshal(authbytes, &d)
+	d.client = client
 
 }
 
@@ -77,18 +76,9 @@ type tagDataModel struct {
 	Color types.String `tfsdk:"color"`
 }
 
-type JSON_tagDataModel struct {
-	ID    int64  `json:"id"`
-	Name  string `json:"name"`
-	Color string `json:"color"`
-}
-
-type tagResponse struct {
-	Tag JSON_tagDataModel `json:"tag"`
-}
-
 // Read refreshes the Terraform state with the latest data.
 func (d *data_tagAuth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withRedaction(ctx)
 
 	var state tagDataModel
 
@@ -98,38 +88,20 @@ func (d *data_tagAuth) Read(ctx context.Context, req datasource.ReadRequest, res
 		return
 	}
 
-	id := cleanString(state.ID.String())
-
-	tflog.Debug(ctx, "Requesting "+d.Host+"/tags/"+id)
+	tflog.Debug(ctx, fmt.Sprintf("Requesting tag %d", state.ID.ValueInt64()))
 
-	var responseString string
-	err := requests.
-		URL(d.Host).
-		Bearer(d.Token).
-		Path("/tags/" + id).
-		ToString(&responseString).
-		Fetch(ctx)
+	tag, err := d.client.GetTag(ctx, state.ID.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error with response",
 			"got "+err.Error(),
 		)
+		return
 	}
 
-	var response tagResponse
-	err = json.Unmarshal([]byte(responseString), &response)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error unmarshalling response",
-			err.Error(),
-		)
-	}
-
-	tflog.Debug(ctx, "Got tag data: "+responseString)
-
-	state.ID = types.Int64Value(response.Tag.ID)
-	state.Name = types.StringValue(response.Tag.Name)
-	state.Color = types.StringValue(response.Tag.Color)
+	state.ID = types.Int64Value(tag.ID)
+	state.Name = types.StringValue(tag.Name)
+	state.Color = types.StringValue(tag.Color)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -0,0 +1,220 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &tagResource{}
+	_ resource.ResourceWithConfigure   = &tagResource{}
+	_ resource.ResourceWithImportState = &tagResource{}
+)
+
+// NewTagResource is a helper function to simplify the provider implementation.
+func NewTagResource() resource.Resource {
+	return &tagResource{}
+}
+
+// tagResource is the resource implementation. It shares tagDataModel with
+// the tag data source.
+type tagResource struct {
+	client *kumaclient.Client
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *tagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Add a nil check when handling ProviderData because Terraform
+	// sets that data after it calls the ConfigureProvider RPC.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*kumaclient.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+	r.client = client
+}
+
+// Metadata returns the resource type name.
+func (r *tagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tag"
+}
+
+// Schema defines the schema for the resource.
+func (r *tagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages a tag that can be attached to monitors.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required: true,
+			},
+			"color": schema.StringAttribute{
+				Description: "Hex color such as \"#2563EB\".",
+				Required:    true,
+				Validators: []validator.String{
+					hexColorValidator{},
+				},
+			},
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *tagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = withRedaction(ctx)
+
+	var plan tagDataModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating tag "+plan.Name.ValueString())
+
+	tag, err := r.client.CreateTag(ctx, &kumaclient.Tag{
+		Name:  plan.Name.ValueString(),
+		Color: plan.Color.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating new tag (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	setTagModel(&plan, tag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *tagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = withRedaction(ctx)
+
+	var state tagDataModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Requesting tag %d", state.ID.ValueInt64()))
+	tag, err := r.client.GetTag(ctx, state.ID.ValueInt64())
+	if kumaclient.IsNotFound(err) {
+		tflog.Warn(ctx, fmt.Sprintf("Tag %d no longer exists, removing it from state", state.ID.ValueInt64()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading tag (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	setTagModel(&state, tag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *tagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = withRedaction(ctx)
+
+	var plan, state tagDataModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating tag %d", state.ID.ValueInt64()))
+	tag, err := r.client.UpdateTag(ctx, state.ID.ValueInt64(), &kumaclient.Tag{
+		Name:  plan.Name.ValueString(),
+		Color: plan.Color.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating tag (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+
+	setTagModel(&plan, tag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *tagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = withRedaction(ctx)
+
+	var state tagDataModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Deleting tag %d", state.ID.ValueInt64()))
+	err := r.client.DeleteTag(ctx, state.ID.ValueInt64())
+	if kumaclient.IsNotFound(err) {
+		// Already gone, which is what we wanted.
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting tag (api call)",
+			"what we know: "+err.Error(),
+		)
+		return
+	}
+}
+
+// ImportState adopts an existing tag by its numeric ID; Read fills in the
+// rest of the attributes.
+func (r *tagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id, err := strconv.ParseInt(req.ID, 10, 64)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected the numeric ID of an Uptime Kuma tag, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func setTagModel(m *tagDataModel, tag *kumaclient.Tag) {
+	m.ID = types.Int64Value(tag.ID)
+	m.Name = types.StringValue(tag.Name)
+	m.Color = types.StringValue(tag.Color)
+}
@@ -0,0 +1,8 @@
+package provider
+
+import "testing"
+
+func TestTagReadRemovesMissing(t *testing.T) {
+	_, client := newFakeBridge(t, nil)
+	readMissing(t, &tagResource{client: client}, int64(3))
+}
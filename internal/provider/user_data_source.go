@@ -2,19 +2,17 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"strings"
 
-	"github.com/carlmjohnson/requests"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
 )
 
 type data_userAuth struct {
-	Host  string
-	Token string
+	client *kumaclient.Client
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -36,16 +34,16 @@ func (d *data_userAuth) Configure(_ context.Context, req datasource.ConfigureReq
 		return
 	}
 
-	authbytes, ok := req.ProviderData.([]byte)
+	client, ok := req.ProviderData.(*kumaclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected []byte, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	json.Unmarshal(authbytes, &d)
+	d.client = client
 
 }
 
@@ -82,15 +80,9 @@ type userDataModel struct {
 	Last_Visit types.String `tfsdk:"last_visit"`
 }
 
-type JSON_userDataModel struct {
-	ID         int64  `json:"id"`
-	Username   string `json:"username"`
-	Created_At string `json:"created_at"`
-	Last_Visit string `json:"last_visit"`
-}
-
 // Read refreshes the Terraform state with the latest data.
 func (d *data_userAuth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withRedaction(ctx)
 
 	var state userDataModel
 
@@ -100,25 +92,18 @@ func (d *data_userAuth) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	username := strings.ReplaceAll(state.Username.ValueString(), "\"", "")
-
-	var tout JSON_userDataModel
-	err := requests.
-		URL(d.Host).
-		Bearer(d.Token).
-		Pathf("/users/" + username).
-		ToJSON(&tout).
-		Fetch(ctx)
+	user, err := d.client.GetUser(ctx, state.Username.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error with response",
 			"got "+err.Error(),
 		)
+		return
 	}
 
-	state.ID = types.Int64Value(tout.ID)
-	state.Created_At = types.StringValue(tout.Created_At)
-	state.Last_Visit = types.StringValue(tout.Last_Visit)
+	state.ID = types.Int64Value(user.ID)
+	state.Created_At = types.StringValue(user.CreatedAt)
+	state.Last_Visit = types.StringValue(user.LastVisit)
 
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -2,18 +2,17 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 
-	"github.com/carlmjohnson/requests"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-uptime-kuma/internal/kumaclient"
 )
 
 type data_usersAuth struct {
-	Host  string
-	Token string
+	client *kumaclient.Client
 }
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -35,16 +34,16 @@ func (d *data_usersAuth) Configure(_ context.Context, req datasource.ConfigureRe
 		return
 	}
 
-	authbytes, ok := req.ProviderData.([]byte)
+	client, ok := req.ProviderData.(*kumaclient.Client)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected []byte, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *kumaclient.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
-	json.Unmarshal(authbytes, &d)
+	d.client = client
 
 }
 
@@ -86,6 +85,7 @@ type usersDataModel struct {
 
 // Read refreshes the Terraform state with the latest data.
 func (d *data_usersAuth) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = withRedaction(ctx)
 
 	var state usersDataModel
 
@@ -95,27 +95,13 @@ func (d *data_usersAuth) Read(ctx context.Context, req datasource.ReadRequest, r
 		return
 	}
 
-	var response string
-	err := requests.
-		URL(d.Host).
-		Bearer(d.Token).
-		Path("/users/").
-		ToString(&response).
-		Fetch(ctx)
+	users, err := d.client.ListUsers(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error with response",
 			"got "+err.Error(),
 		)
-	}
-
-	var users []JSON_userDataModel
-	err = json.Unmarshal([]byte(response), &users)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error unmarshalling response",
-			err.Error(),
-		)
+		return
 	}
 
 	var tout usersDataModel
@@ -123,8 +109,8 @@ func (d *data_usersAuth) Read(ctx context.Context, req datasource.ReadRequest, r
 		tout.Users = append(tout.Users, userDataModel{
 			ID:         types.Int64Value(user.ID),
 			Username:   types.StringValue(user.Username),
-			Created_At: types.StringValue(user.Created_At),
-			Last_Visit: types.StringValue(user.Last_Visit),
+			Created_At: types.StringValue(user.CreatedAt),
+			Last_Visit: types.StringValue(user.LastVisit),
 		})
 	}
 
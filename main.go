@@ -7,7 +7,6 @@ import (
 	"context"
 	"flag"
 	"log"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 
@@ -23,16 +22,6 @@ var (
 	// https://goreleaser.com/cookbooks/using-main.version/
 )
 
-// helper funcs
-func cleanString(dirty string) string {
-
-	tout := strings.Trim(dirty, "\"")
-	tout = strings.TrimLeft(tout, "<")
-	tout = strings.TrimRight(tout, ">")
-
-	return tout
-}
-
 func main() {
 	var debug bool
 